@@ -0,0 +1,70 @@
+package rbbi
+
+import (
+	"unicode/utf8"
+)
+
+// The AlignmentPolicy determines how a Cursor handles a position that is in
+// the middle of the UTF-8 encoding of a rune. Such positions would make the
+// break iterator decode the continuation bytes as separate invalid runes,
+// which silently results in wrong breaks.
+type AlignmentPolicy int
+
+const (
+	// Reject positions that are not at a rune boundary by returning
+	// ErrMisalignedPosition. This is the default policy.
+	AlignmentReject AlignmentPolicy = iota
+
+	// Move positions that are not at a rune boundary back to the start of the
+	// rune they intersect.
+	AlignmentSnapBackward
+
+	// Move positions that are not at a rune boundary forward to the end of the
+	// rune they intersect.
+	AlignmentSnapForward
+)
+
+// Align a position in a UTF-8 string according to the policy. The text may be
+// a window of a larger text, in which case it should contain at least
+// utf8.UTFMax-1 bytes on either side of the position (or up to the start or
+// end of the larger text). Bytes that are not part of a valid UTF-8 sequence
+// are treated as runes of their own, just like StringCursor decodes them.
+func (p AlignmentPolicy) align(text string, position int) (int, error) {
+	start, size := runeContaining(text, position)
+	if start == position {
+		return position, nil
+	}
+
+	switch p {
+	case AlignmentSnapBackward:
+		return start, nil
+	case AlignmentSnapForward:
+		return start + size, nil
+	}
+
+	return -1, ErrMisalignedPosition
+}
+
+// Return the start and size of the rune containing the byte at the provided
+// position. A position at the end of the string is its own start.
+func runeContaining(text string, position int) (start, size int) {
+	// The start of an encoded rune can't be part of another rune
+	if position == len(text) || utf8.RuneStart(text[position]) {
+		return position, 0
+	}
+
+	for k := 1; k < utf8.UTFMax && k <= position; k++ {
+		if !utf8.RuneStart(text[position-k]) {
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(text[position-k:])
+		if size > k {
+			return position - k, size
+		}
+
+		break
+	}
+
+	return position, 0
+}
@@ -0,0 +1,148 @@
+package rbbi
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRuneContaining(t *testing.T) {
+	// The last two bytes are a truncated rune followed by a stray
+	// continuation byte, which are decoded as separate runes.
+	str := "aö🐨\xf0\x9f\x90"
+	expected := []struct {
+		start int
+		size  int
+	}{
+		{0, 0}, {1, 0}, {1, 2}, {3, 0}, {3, 4}, {3, 4}, {3, 4}, {7, 0}, {8, 0}, {9, 0}, {10, 0},
+	}
+
+	for position, e := range expected {
+		if start, size := runeContaining(str, position); start != e.start || size != e.size {
+			t.Errorf("Invalid rune (%v, %v) containing position %v", start, size, position)
+		}
+	}
+}
+
+func testAlignment(t *testing.T, newCursor func(str string, policy AlignmentPolicy) Cursor) {
+	str := "aö🐨b"
+
+	expected := map[AlignmentPolicy][]int{
+		AlignmentReject:       {0, 1, -1, 3, -1, -1, -1, 7, 8},
+		AlignmentSnapBackward: {0, 1, 1, 3, 3, 3, 3, 7, 8},
+		AlignmentSnapForward:  {0, 1, 3, 3, 7, 7, 7, 7, 8},
+	}
+
+	for policy, positions := range expected {
+		cursor := newCursor(str, policy)
+
+		for position, aligned := range positions {
+			cursor.SetPosition(0)
+			err := cursor.SetPosition(position)
+
+			if aligned == -1 {
+				if !errors.Is(err, ErrMisalignedPosition) {
+					t.Errorf("Expected misaligned position %v to be rejected, got %v", position, err)
+				}
+
+				if cursor.Position() != 0 {
+					t.Errorf("Rejected position %v moved the cursor", position)
+				}
+
+				continue
+			}
+
+			if err != nil || cursor.Position() != aligned {
+				t.Errorf("Invalid aligned position %v for %v with policy %v", cursor.Position(), position, policy)
+			}
+
+			if r, _ := cursor.Next(); position < len(str) && r != []rune(str[aligned:])[0] {
+				t.Errorf("Invalid rune %q after setting position %v", r, position)
+			}
+		}
+
+		for _, position := range []int{-1, len(str) + 1} {
+			if err := cursor.SetPosition(position); !errors.Is(err, ErrPositionOutOfRange) {
+				t.Errorf("Expected position %v to be out of range, got %v", position, err)
+			}
+		}
+	}
+}
+
+func TestStringCursorAlignment(t *testing.T) {
+	testAlignment(t, func(str string, policy AlignmentPolicy) Cursor {
+		cursor := NewStringCursor(str)
+		cursor.SetAlignmentPolicy(policy)
+
+		return cursor
+	})
+}
+
+func TestRopeCursorAlignment(t *testing.T) {
+	testAlignment(t, func(str string, policy AlignmentPolicy) Cursor {
+		// Build the rope from pieces so that runes are split across nodes
+		rope := NewRope("")
+		rope.Insert(0, str[5:])
+		rope.Insert(0, str[:5])
+
+		cursor := rope.NewCursor()
+		cursor.SetAlignmentPolicy(policy)
+
+		return cursor
+	})
+}
+
+func TestMisalignedPositionError(t *testing.T) {
+	str := "aö🐨b"
+
+	iter := NewCharacterRBBI()
+	iter.SetCursor(NewStringCursor(str))
+
+	if _, ok := iter.Following(2); ok {
+		t.Error("Following was ok for a misaligned position")
+	}
+
+	var cursorErr *CursorError
+	if !errors.As(iter.Err(), &cursorErr) || cursorErr.Position != 2 {
+		t.Errorf("Expected a CursorError for position 2, got %v", iter.Err())
+	}
+
+	if !errors.Is(iter.Err(), ErrCursor) || !errors.Is(iter.Err(), ErrMisalignedPosition) {
+		t.Errorf("CursorError does not match ErrCursor and ErrMisalignedPosition")
+	}
+
+	// A misaligned position is never a break, so it's not an error
+	iter.SetCursor(NewStringCursor(str))
+	if iter.IsBoundary(5) || iter.Err() != nil {
+		t.Error("IsBoundary did not reject a misaligned position")
+	}
+
+	if iter.IsBoundary(9) || iter.Err() == nil {
+		t.Error("IsBoundary did not report a position out of range")
+	}
+}
+
+func TestSnappedPositions(t *testing.T) {
+	str := "aö🐨b"
+
+	cursor := NewStringCursor(str)
+	cursor.SetAlignmentPolicy(AlignmentSnapBackward)
+
+	iter := NewCharacterRBBI()
+	iter.SetCursor(cursor)
+
+	if pos, ok := iter.Following(5); !ok || pos != 7 {
+		t.Errorf("Invalid following break %v for a snapped position", pos)
+	}
+
+	if pos, ok := iter.Preceding(5); !ok || pos != 1 {
+		t.Errorf("Invalid preceding break %v for a snapped position", pos)
+	}
+
+	if iter.IsBoundary(5) {
+		t.Error("IsBoundary was true for a snapped position")
+	}
+
+	if iter.Err() != nil {
+		t.Errorf("Unexpected error %v", iter.Err())
+	}
+}
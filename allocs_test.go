@@ -0,0 +1,62 @@
+package rbbi
+
+import (
+	"testing"
+)
+
+// Iteration over a StringCursor must not allocate, so that break iterators
+// can be used in hot loops.
+func TestAllocs(t *testing.T) {
+	constructors := map[string]func() *RBBI{
+		"character": NewCharacterRBBI,
+		"line":      NewLineRBBI,
+		"sentence":  NewSentenceRBBI,
+		"word":      NewWordRBBI,
+	}
+
+	for name, newRBBI := range constructors {
+		for _, c := range loadCorpora(t) {
+			iter := newRBBI()
+			cursor := NewStringCursor(c.text)
+			iter.SetCursor(cursor)
+
+			operations := map[string]func(){
+				"Next": func() {
+					cursor.SetPosition(0)
+					for _, ok := iter.Next(); ok; _, ok = iter.Next() {
+					}
+				},
+				"Previous": func() {
+					cursor.SetPosition(len(c.text))
+					for _, ok := iter.Previous(); ok; _, ok = iter.Previous() {
+					}
+				},
+				"Following": func() {
+					for _, offset := range c.offsets {
+						iter.Following(offset)
+					}
+				},
+				"Preceding": func() {
+					for _, offset := range c.offsets {
+						iter.Preceding(offset)
+					}
+				},
+				"IsBoundary": func() {
+					for _, offset := range c.offsets {
+						iter.IsBoundary(offset)
+					}
+				},
+			}
+
+			for operation, fn := range operations {
+				if allocs := testing.AllocsPerRun(3, fn); allocs != 0 {
+					t.Errorf("%v: %v allocated %v times on the %v corpus", name, operation, allocs, c.name)
+				}
+			}
+
+			if iter.Err() != nil {
+				t.Errorf("%v: unexpected error %v on the %v corpus", name, iter.Err(), c.name)
+			}
+		}
+	}
+}
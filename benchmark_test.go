@@ -0,0 +1,265 @@
+package rbbi
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var benchmarkText = strings.Repeat("The quick (“brown”) fox can’t jump 32.3 feet, right? Größe 🐨🏴‍☠️ 日本語のテキスト。\n", 100)
+
+var benchmarkEnglishText = strings.Repeat("It was the best of times, it was the worst of times, it was the age of "+
+	"wisdom, it was the age of foolishness. There were a king with a large jaw and a queen with a plain "+
+	"face, on the throne of England; in 1775 it was clearer than crystal to the lords of the State "+
+	"preserves of loaves and fishes, that things in general were settled for ever!\n", 25)
+
+func benchmarkNext(b *testing.B, rules *Rules) {
+	benchmarkNextText(b, rules, benchmarkText)
+}
+
+func benchmarkNextText(b *testing.B, rules *Rules, text string) {
+	iter := rules.NewRBBI()
+	cursor := NewStringCursor(text)
+
+	b.SetBytes(int64(len(text)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		cursor.SetPosition(0)
+		iter.SetCursor(cursor)
+
+		for {
+			if _, ok := iter.Next(); !ok {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkNextCharacter(b *testing.B) {
+	benchmarkNext(b, CharacterRules())
+}
+
+func BenchmarkNextLine(b *testing.B) {
+	benchmarkNext(b, LineRules())
+}
+
+func BenchmarkNextSentence(b *testing.B) {
+	benchmarkNext(b, SentenceRules())
+}
+
+func BenchmarkNextWord(b *testing.B) {
+	benchmarkNext(b, WordRules())
+}
+
+func BenchmarkNextEnglishCharacter(b *testing.B) {
+	benchmarkNextText(b, CharacterRules(), benchmarkEnglishText)
+}
+
+func BenchmarkNextEnglishLine(b *testing.B) {
+	benchmarkNextText(b, LineRules(), benchmarkEnglishText)
+}
+
+func BenchmarkNextEnglishSentence(b *testing.B) {
+	benchmarkNextText(b, SentenceRules(), benchmarkEnglishText)
+}
+
+func BenchmarkNextEnglishWord(b *testing.B) {
+	benchmarkNextText(b, WordRules(), benchmarkEnglishText)
+}
+
+func benchmarkAppendBoundaries(b *testing.B, rules *Rules, text string) {
+	iter := rules.NewRBBI()
+	boundaries := iter.AppendBoundaries(nil, text)
+
+	b.SetBytes(int64(len(text)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		boundaries = iter.AppendBoundaries(boundaries[:0], text)
+	}
+}
+
+func BenchmarkAppendBoundariesCharacter(b *testing.B) {
+	benchmarkAppendBoundaries(b, CharacterRules(), benchmarkText)
+}
+
+func BenchmarkAppendBoundariesLine(b *testing.B) {
+	benchmarkAppendBoundaries(b, LineRules(), benchmarkText)
+}
+
+func BenchmarkAppendBoundariesSentence(b *testing.B) {
+	benchmarkAppendBoundaries(b, SentenceRules(), benchmarkText)
+}
+
+func BenchmarkAppendBoundariesWord(b *testing.B) {
+	benchmarkAppendBoundaries(b, WordRules(), benchmarkText)
+}
+
+func BenchmarkAppendBoundariesEnglishWord(b *testing.B) {
+	benchmarkAppendBoundaries(b, WordRules(), benchmarkEnglishText)
+}
+
+func BenchmarkAppendBoundariesBytesWord(b *testing.B) {
+	iter := NewWordRBBI()
+	text := []byte(benchmarkText)
+	boundaries := iter.AppendBoundariesBytes(nil, text)
+
+	b.SetBytes(int64(len(text)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		boundaries = iter.AppendBoundariesBytes(boundaries[:0], text)
+	}
+}
+
+// The Cursor-based loop for comparison, using a Cursor that is called through
+// the interface for every rune
+func BenchmarkNextRopeWord(b *testing.B) {
+	iter := NewWordRBBI()
+	cursor := NewRope(benchmarkText).NewCursor()
+
+	b.SetBytes(int64(len(benchmarkText)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		cursor.SetPosition(0)
+		iter.SetCursor(cursor)
+
+		for {
+			if _, ok := iter.Next(); !ok {
+				break
+			}
+		}
+	}
+}
+
+// A text from testdata, with pseudo-random rune-aligned offsets for
+// benchmarking random access.
+type corpus struct {
+	name    string
+	text    string
+	offsets []int
+}
+
+var corpusNames = []string{"english", "german", "arabic", "hindi", "cjk", "chat", "source"}
+
+var (
+	corporaOnce sync.Once
+	corpora     []corpus
+	corporaErr  error
+)
+
+// Return the corpora in testdata, which are loaded once.
+func loadCorpora(tb testing.TB) []corpus {
+	tb.Helper()
+
+	corporaOnce.Do(func() {
+		for _, name := range corpusNames {
+			text, err := os.ReadFile(filepath.Join("testdata", name+".txt"))
+			if err != nil {
+				corporaErr = err
+				return
+			}
+
+			c := corpus{name: name, text: string(text)}
+
+			state := uint32(len(text))
+			for i := 0; i < 200; i++ {
+				state ^= state << 13
+				state ^= state >> 17
+				state ^= state << 5
+
+				offset, _ := runeContaining(c.text, int(state%uint32(len(text)+1)))
+				c.offsets = append(c.offsets, offset)
+			}
+
+			corpora = append(corpora, c)
+		}
+	})
+
+	if corporaErr != nil {
+		tb.Fatal(corporaErr)
+	}
+
+	return corpora
+}
+
+func benchmarkCorpora(b *testing.B, newRBBI func() *RBBI) {
+	for _, c := range loadCorpora(b) {
+		c := c
+
+		b.Run(c.name+"/next", func(b *testing.B) {
+			iter := newRBBI()
+			cursor := NewStringCursor(c.text)
+
+			b.SetBytes(int64(len(c.text)))
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				cursor.SetPosition(0)
+				iter.SetCursor(cursor)
+
+				for _, ok := iter.Next(); ok; _, ok = iter.Next() {
+				}
+			}
+		})
+
+		b.Run(c.name+"/previous", func(b *testing.B) {
+			iter := newRBBI()
+			cursor := NewStringCursor(c.text)
+
+			b.SetBytes(int64(len(c.text)))
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				cursor.SetPosition(len(c.text))
+				iter.SetCursor(cursor)
+
+				for _, ok := iter.Previous(); ok; _, ok = iter.Previous() {
+				}
+			}
+		})
+
+		// Report the time per lookup, instead of per iteration of the text
+		b.Run(c.name+"/random", func(b *testing.B) {
+			iter := newRBBI()
+			iter.SetCursor(NewStringCursor(c.text))
+
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				offset := c.offsets[i%len(c.offsets)]
+
+				switch i % 3 {
+				case 0:
+					iter.Following(offset)
+				case 1:
+					iter.Preceding(offset)
+				default:
+					iter.IsBoundary(offset)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkCharacterRBBI(b *testing.B) {
+	benchmarkCorpora(b, NewCharacterRBBI)
+}
+
+func BenchmarkLineRBBI(b *testing.B) {
+	benchmarkCorpora(b, NewLineRBBI)
+}
+
+func BenchmarkSentenceRBBI(b *testing.B) {
+	benchmarkCorpora(b, NewSentenceRBBI)
+}
+
+func BenchmarkWordRBBI(b *testing.B) {
+	benchmarkCorpora(b, NewWordRBBI)
+}
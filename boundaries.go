@@ -0,0 +1,168 @@
+package rbbi
+
+import (
+	"unicode/utf8"
+)
+
+// Append every break of the text to dst and return the extended slice. The
+// breaks are byte offsets in increasing order, starting with 0 and ending with
+// len(text), which is the same sequence as iterating a StringCursor over the
+// text from the start using Next(), but without calling the Cursor for every
+// rune. No memory is allocated other than for growing dst.
+//
+// The Cursor of the break iterator is not used, and its position is left
+// unchanged. When iteration is stopped by a corrupt table the breaks found so
+// far are returned, and the error can be retrieved using Err(). Like the other
+// iteration functions, nothing is appended after an error occurred, until a
+// new Cursor is assigned using SetCursor().
+func (r *RBBI) AppendBoundaries(dst []int, text string) []int {
+	return r.appendBoundaries(dst, text, nil)
+}
+
+// Append every break of a UTF-8 encoded byte slice to dst and return the
+// extended slice. This is the byte slice variant of AppendBoundaries().
+func (r *RBBI) AppendBoundariesBytes(dst []int, text []byte) []int {
+	return r.appendBoundaries(dst, "", text)
+}
+
+// Decode the rune at a byte offset of either the string or, when it is not
+// nil, the byte slice. Invalid UTF-8 is decoded the same way as by the
+// StringCursor, as utf8.RuneError with a size of 1.
+func decodeRune(text string, bytes []byte, position int) (rune, int) {
+	if bytes != nil {
+		if c := bytes[position]; c < utf8.RuneSelf {
+			return rune(c), 1
+		}
+
+		return utf8.DecodeRune(bytes[position:])
+	}
+
+	if c := text[position]; c < utf8.RuneSelf {
+		return rune(c), 1
+	}
+
+	return utf8.DecodeRuneInString(text[position:])
+}
+
+// Run the forward state machine over the string or byte slice, appending every
+// break to dst. This is the same algorithm as handleNext(), with the Cursor
+// replaced by a byte offset that is advanced over the text directly.
+func (r *RBBI) appendBoundaries(dst []int, text string, bytes []byte) []int {
+	if r.err != nil {
+		return dst
+	}
+
+	// The tables must be consistent, so that the state machine does not need
+	// to check them
+	if err := r.rules.validate(); err != nil {
+		r.err = err
+		return dst
+	}
+
+	length := len(text)
+	if bytes != nil {
+		length = len(bytes)
+	}
+
+	latin1Categories := &r.rules.latin1Categories
+	table := &r.data.forwardTable
+	rowLength := int(table.rowLength)
+
+	dst = append(dst, 0)
+
+	for position := 0; position < length; {
+		// Lookahead results from a previous break do not apply
+		for i := range r.lookaheadMatches {
+			r.lookaheadMatches[i] = -1
+		}
+
+		// The offset following the last rune that was read, which is where
+		// a Cursor would be positioned
+		c, size := decodeRune(text, bytes, position)
+		offset := position + size
+		nextOk := true
+
+		result := position
+		state := rbbiStateStart
+		row := int(state) * rowLength
+
+		var category uint32
+		mode := rbbiRunModeRun
+		if table.bofRequired {
+			category = 2
+			mode = rbbiRunModeStart
+		}
+
+		lookaheadResult := -1
+
+		for {
+			if !nextOk {
+				if mode == rbbiRunModeEnd {
+					break
+				}
+
+				mode = rbbiRunModeEnd
+				category = 1
+			}
+
+			if mode == rbbiRunModeRun {
+				if c >= 0 && c < 256 {
+					category = uint32(latin1Categories[c])
+				} else {
+					var err error
+					category, err = r.category(c)
+					if err != nil {
+						r.err = err
+						return dst
+					}
+				}
+			}
+
+			state = int32(table.value(row + rbbiRowNextStates + int(category)))
+			row = int(state) * rowLength
+
+			accepting := table.value(row + rbbiRowAccepting)
+			if accepting == int(rbbiAcceptingUnconditional) {
+				if mode != rbbiRunModeStart {
+					result = offset
+				}
+			} else if accepting > int(rbbiAcceptingUnconditional) {
+				if lookaheadResult = r.lookaheadMatches[accepting]; lookaheadResult >= 0 {
+					break
+				}
+			}
+
+			if rule := table.value(row + rbbiRowLookahead); rule > int(rbbiAcceptingUnconditional) {
+				r.lookaheadMatches[rule] = offset
+			}
+
+			if state == rbbiStateStop {
+				break
+			}
+
+			if mode == rbbiRunModeRun {
+				if offset < length {
+					c, size = decodeRune(text, bytes, offset)
+					offset += size
+				} else {
+					nextOk = false
+				}
+			} else if mode == rbbiRunModeStart {
+				mode = rbbiRunModeRun
+			}
+		}
+
+		if lookaheadResult >= 0 {
+			result = lookaheadResult
+		} else if result == position {
+			// Force the iterator ahead by one rune, like handleNext() does
+			_, size := decodeRune(text, bytes, position)
+			result = position + size
+		}
+
+		dst = append(dst, result)
+		position = result
+	}
+
+	return dst
+}
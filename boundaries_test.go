@@ -0,0 +1,99 @@
+package rbbi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAppendBoundaries(t *testing.T) {
+	strs := []string{
+		"",
+		"a",
+		"The quick (“brown”) fox can’t jump 32.3 feet, right?\nGröße 🐨🏴‍☠️❤️‍🔥 日本語のテキスト。 क्षत्रिय",
+		"Mr. Smith paid $3.50 (e.g. for “tea”).\r\n\r\nThen he left!  ",
+		"Z̤͔ͧ̑̓ä͖̭̈̇lͮ̒ͫǧ̗͚̚o̙̔ͮ̇͐̇",
+		"\xff\x80abc\xf0\x9f\x90 \xe6\x97",
+	}
+
+	for _, rules := range []*Rules{CharacterRules(), LineRules(), SentenceRules(), WordRules()} {
+		iter := rules.NewRBBI()
+
+		for _, str := range strs {
+			expected := allBoundaries(rules.NewRBBI(), str)
+
+			if boundaries := iter.AppendBoundaries(nil, str); !reflect.DeepEqual(boundaries, expected) {
+				t.Errorf("Invalid boundaries %v for %q, expected %v", boundaries, str, expected)
+			}
+
+			if boundaries := iter.AppendBoundariesBytes(nil, []byte(str)); !reflect.DeepEqual(boundaries, expected) {
+				t.Errorf("Invalid boundaries %v for bytes %q, expected %v", boundaries, str, expected)
+			}
+		}
+
+		if iter.Err() != nil {
+			t.Errorf("Unexpected error %v", iter.Err())
+		}
+	}
+}
+
+func TestAppendBoundariesKeepsCursor(t *testing.T) {
+	iter := NewWordRBBI()
+	iter.SetCursor(NewStringCursor("hello world"))
+	iter.Next()
+
+	dst := iter.AppendBoundaries([]int{-1}, "a b")
+	if !reflect.DeepEqual(dst, []int{-1, 0, 1, 2, 3}) {
+		t.Errorf("Invalid appended boundaries %v", dst)
+	}
+
+	if pos, _ := iter.Next(); pos != 6 {
+		t.Errorf("AppendBoundaries moved the Cursor, Next returned %v", pos)
+	}
+}
+
+func TestAppendBoundariesAllocs(t *testing.T) {
+	iter := NewLineRBBI()
+	dst := iter.AppendBoundaries(nil, benchmarkText)
+	bytes := []byte(benchmarkText)
+
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = iter.AppendBoundaries(dst[:0], benchmarkText)
+		dst = iter.AppendBoundariesBytes(dst[:0], bytes)
+	})
+
+	if allocs != 0 {
+		t.Errorf("AppendBoundaries allocated %v times", allocs)
+	}
+}
+
+func TestAppendBoundariesCorrupt(t *testing.T) {
+	data := copyData(decodedData(&characterRules))
+	setNextStates(&data.forwardTable, int(rbbiStateStart), 200)
+
+	iter := newBrokenRBBI(data, "")
+	if boundaries := iter.AppendBoundaries(nil, "abc"); len(boundaries) != 0 {
+		t.Errorf("Boundaries %v were found with a corrupt state table", boundaries)
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+}
+
+func TestAppendBoundariesAfterError(t *testing.T) {
+	iter := NewCharacterRBBI()
+	iter.SetCursor(&stuckCursor{StringCursor{text: "hello"}})
+
+	if _, ok := iter.Next(); ok {
+		t.Error("Next was ok with a stuck Cursor")
+	}
+
+	if boundaries := iter.AppendBoundaries(nil, "abc"); len(boundaries) != 0 {
+		t.Errorf("Boundaries %v were found after an error", boundaries)
+	}
+
+	expectError(t, iter, ErrCursor)
+
+	iter.SetCursor(NewStringCursor(""))
+	if boundaries := iter.AppendBoundaries(nil, "abc"); !reflect.DeepEqual(boundaries, []int{0, 1, 2, 3}) {
+		t.Errorf("Invalid boundaries %v after SetCursor", boundaries)
+	}
+}
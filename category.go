@@ -0,0 +1,132 @@
+package rbbi
+
+import (
+	"unicode"
+)
+
+// A CategoryRange is an inclusive range of code points with the same
+// category.
+type CategoryRange struct {
+	Lo rune
+	Hi rune
+}
+
+// Return the number of character categories of the rules. Categories are
+// numbered from 0, where 0 is unused and 1 and 2 are the pseudo categories
+// for the end and the start of the text, which are not assigned to any rune.
+func (r *Rules) CategoryCount() int {
+	r.validate()
+	return int(r.data.categoryCount)
+}
+
+// Return the human-readable name of a category, such as "ALetter" for word
+// breaks or "AL" for line breaks. The names follow the Unicode property
+// values where possible, and the variable names of the ICU rules for
+// categories that split or combine them. An empty string is returned for
+// categories that don't exist.
+func (r *Rules) CategoryName(category int) string {
+	r.validate()
+	if category < 0 || category >= len(r.data.categoryNames) {
+		return ""
+	}
+
+	return r.data.categoryNames[category]
+}
+
+// Return the category of a rune, which is the break property class the rules
+// assign to it, as used by the state machines. Runes outside the Unicode
+// range have the category of the error value of the trie. Returns -1 when the
+// tables are corrupt.
+func (r *Rules) Category(c rune) int {
+	if r.validate() != nil {
+		return -1
+	}
+
+	if c >= 0 && c < rune(len(r.latin1Categories)) {
+		return int(r.latin1Categories[c])
+	}
+
+	category, err := r.data.trie.get(c)
+	if err != nil || category >= r.data.categoryCount {
+		return -1
+	}
+
+	return int(category)
+}
+
+// Return the category of a rune according to the rules of the break iterator.
+// See Rules.Category().
+func (r *RBBI) Category(c rune) int {
+	return r.rules.Category(c)
+}
+
+// Return the code point ranges of a category, in increasing order. The ranges
+// are computed by enumerating the whole trie, so the result should be kept
+// when it's used more than once. An empty result is returned for categories
+// that don't exist and for the pseudo categories. Returns an error wrapping
+// ErrCorruptTable when the tables are inconsistent.
+func (r *Rules) CategoryRanges(category int) ([]CategoryRange, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	ranges, err := r.categoryRanges()
+	if err != nil || category < 0 || category >= len(ranges) {
+		return nil, err
+	}
+
+	return ranges[category], nil
+}
+
+// Return the code points of a category as a unicode.RangeTable, for use with
+// unicode.Is() in pre-filters. See CategoryRanges().
+func (r *Rules) CategoryTable(category int) (*unicode.RangeTable, error) {
+	ranges, err := r.CategoryRanges(category)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &unicode.RangeTable{}
+	for _, cr := range ranges {
+		if cr.Hi <= 0xffff {
+			table.R16 = append(table.R16, unicode.Range16{Lo: uint16(cr.Lo), Hi: uint16(cr.Hi), Stride: 1})
+			if cr.Hi <= unicode.MaxLatin1 {
+				table.LatinOffset++
+			}
+
+			continue
+		}
+
+		// A range crossing the end of the BMP is split, because R16 and R32
+		// must not overlap
+		if cr.Lo <= 0xffff {
+			table.R16 = append(table.R16, unicode.Range16{Lo: uint16(cr.Lo), Hi: 0xffff, Stride: 1})
+			cr.Lo = 0x10000
+		}
+
+		table.R32 = append(table.R32, unicode.Range32{Lo: uint32(cr.Lo), Hi: uint32(cr.Hi), Stride: 1})
+	}
+
+	return table, nil
+}
+
+// Return the code point ranges of every category, in increasing order.
+func (r *Rules) categoryRanges() ([][]CategoryRange, error) {
+	ranges := make([][]CategoryRange, r.data.categoryCount)
+
+	for start := rune(0); start <= ucpTrieMaxUnicode; {
+		end, category, err := r.data.trie.getRange(start)
+		if err != nil {
+			return nil, err
+		}
+
+		if category >= r.data.categoryCount {
+			return nil, corruptTableError("category %v of rune %U is out of range", category, start)
+		}
+
+		ranges[category] = append(ranges[category], CategoryRange{start, end})
+		start = end + 1
+	}
+
+	return ranges, nil
+}
@@ -0,0 +1,174 @@
+package rbbi
+
+import (
+	"errors"
+	"testing"
+	"unicode"
+)
+
+func TestCategoryNames(t *testing.T) {
+	tests := []struct {
+		rules *Rules
+		names map[rune]string
+	}{
+		{CharacterRules(), map[rune]string{
+			'\r':     "CR",
+			'\n':     "LF",
+			'\t':     "Control",
+			'a':      "Other",
+			'\u0301': "InCB_Extend",
+			'\u093E': "SpacingMark",
+			'क':      "InCB_Consonant",
+			'\u094D': "InCB_Linker",
+			'\u200D': "ZWJ",
+			'ᄀ':      "L",
+			'ᅡ':      "V",
+			'ᆨ':      "T",
+			'가':      "LV",
+			'각':      "LVT",
+			'🇳':      "Regional_Indicator",
+			'😀':      "Extended_Pictographic",
+		}},
+		{WordRules(), map[rune]string{
+			'a':      "ALetter",
+			'0':      "Numeric",
+			' ':      "WSegSpace",
+			'"':      "Double_Quote",
+			'\'':     "Single_Quote",
+			'.':      "MidNumLet",
+			',':      "MidNum",
+			':':      "MidLetter",
+			'_':      "ExtendNumLet",
+			'\u0301': "Extend_Format",
+			'\u200D': "ZWJ",
+			'א':      "Hebrew_Letter",
+			'ア':      "Katakana",
+			'あ':      "Hiragana",
+			'한':      "Hangul_Syllable",
+			'ก':      "Complex_Context",
+			'一':      "Han_Ideographic",
+			'😀':      "Extended_Pictographic",
+			'🇳':      "Regional_Indicator",
+		}},
+		{LineRules(), map[rune]string{
+			'a':      "AL",
+			'0':      "NU",
+			' ':      "SP",
+			'\n':     "LF",
+			'(':      "OP30",
+			')':      "CP",
+			'（':      "OP_East_Asian",
+			'。':      "CL",
+			'-':      "HY",
+			'‐':      "HH",
+			'!':      "EX",
+			'"':      "QU",
+			'$':      "PR",
+			'%':      "PO",
+			'\u00A0': "GL",
+			'\u200B': "ZW",
+			'\u2060': "WJ",
+			'一':      "ID",
+			'ー':      "NS",
+			'ก':      "SA",
+			'א':      "HL",
+		}},
+		{SentenceRules(), map[rune]string{
+			'a':      "Lower",
+			'A':      "Upper",
+			'0':      "Numeric",
+			'.':      "ATerm",
+			'!':      "STerm",
+			')':      "Close",
+			',':      "SContinue",
+			' ':      "Sp",
+			'\u2029': "Sep",
+			'\u0301': "Extend_Format",
+			'あ':      "OLetter",
+		}},
+	}
+
+	for _, test := range tests {
+		iter := test.rules.NewRBBI()
+
+		for c, name := range test.names {
+			if category := iter.Category(c); test.rules.CategoryName(category) != name {
+				t.Errorf("Rune %U has category %v %q instead of %q", c, category, test.rules.CategoryName(category), name)
+			}
+		}
+
+		for category := 0; category < test.rules.CategoryCount(); category++ {
+			if test.rules.CategoryName(category) == "" {
+				t.Errorf("Category %v has no name", category)
+			}
+		}
+
+		if test.rules.CategoryName(-1) != "" || test.rules.CategoryName(test.rules.CategoryCount()) != "" {
+			t.Error("Categories that don't exist have a name")
+		}
+	}
+}
+
+func TestCategoryRanges(t *testing.T) {
+	for _, rules := range []*Rules{CharacterRules(), LineRules(), SentenceRules(), WordRules()} {
+		total := 0
+
+		for category := 0; category < rules.CategoryCount(); category++ {
+			ranges, err := rules.CategoryRanges(category)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			table, err := rules.CategoryTable(category)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			previous := rune(-1)
+			for _, cr := range ranges {
+				if cr.Lo <= previous || cr.Hi < cr.Lo {
+					t.Fatalf("Invalid range %U..%U of category %v", cr.Lo, cr.Hi, category)
+				}
+
+				for _, c := range []rune{cr.Lo, cr.Hi, cr.Lo + (cr.Hi-cr.Lo)/2} {
+					if rules.Category(c) != category {
+						t.Errorf("Rune %U is in a range of category %v instead of %v", c, category, rules.Category(c))
+					}
+
+					if !unicode.Is(table, c) {
+						t.Errorf("Rune %U is missing from the table of category %v", c, category)
+					}
+				}
+
+				if cr.Lo > 0 && unicode.Is(table, cr.Lo-1) && rules.Category(cr.Lo-1) != category {
+					t.Errorf("Rune %U is in the table of category %v", cr.Lo-1, category)
+				}
+
+				total += int(cr.Hi-cr.Lo) + 1
+				previous = cr.Hi
+			}
+		}
+
+		if total != unicode.MaxRune+1 {
+			t.Errorf("The categories contain %v code points", total)
+		}
+
+		if ranges, err := rules.CategoryRanges(rules.CategoryCount()); err != nil || len(ranges) != 0 {
+			t.Error("A category that doesn't exist has ranges")
+		}
+	}
+}
+
+func TestCategoryCorrupt(t *testing.T) {
+	data := copyData(decodedData(&wordRules))
+	data.categoryNames = data.categoryNames[1:]
+
+	rules := &Rules{data: data}
+	if rules.Category('a') != -1 {
+		t.Error("A category was returned for corrupt tables")
+	}
+
+	if _, err := rules.CategoryRanges(3); !errors.Is(err, ErrCorruptTable) {
+		t.Errorf("Invalid error %v", err)
+	}
+}
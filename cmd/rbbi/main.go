@@ -0,0 +1,227 @@
+// Command rbbi prints the segments of text for a kind of break, for debugging
+// segmentation questions.
+//
+// Usage:
+//
+//	rbbi [-type char|word|line|sentence] [-format lines|json|visual] [-trace] [file ...]
+//	rbbi [-type char|word|line|sentence] -dump forward|reverse|categories
+//
+// The files are read in order and segmented separately. Standard input is
+// read when no files are provided, or for a file named "-".
+//
+// The output formats are:
+//
+//	lines   one segment per line, as a quoted Go string so that white space
+//	        and invisible characters can be told apart
+//	json    a JSON object per file, with the byte offsets, text and rule
+//	        statuses of every segment
+//	visual  the text with every break marked by a '|'
+//
+// With -trace every step of the state machines is written to standard error.
+//
+// With -dump no text is read. Instead, the forward or reverse state table of
+// the rules is written as a Graphviz DOT graph, or the code point ranges of
+// every character category are listed.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	rbbi "github.com/thedjinn/rbbi-go"
+)
+
+var constructors = map[string]func() *rbbi.RBBI{
+	"char":     rbbi.NewCharacterRBBI,
+	"word":     rbbi.NewWordRBBI,
+	"line":     rbbi.NewLineRBBI,
+	"sentence": rbbi.NewSentenceRBBI,
+}
+
+// A segment of the text, as written in the JSON output format.
+type segment struct {
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+	Text     string `json:"text"`
+	Status   int    `json:"status"`
+	Statuses []int  `json:"statuses"`
+}
+
+// The JSON output for a single file.
+type document struct {
+	Name     string    `json:"name"`
+	Segments []segment `json:"segments"`
+}
+
+// Return the segments of a text, ending at the breaks found by the iterator.
+func segments(iter *rbbi.RBBI, text string) ([]segment, error) {
+	iter.SetCursor(rbbi.NewStringCursor(text))
+
+	result := []segment{}
+	start := 0
+
+	for {
+		end, ok := iter.Next()
+		if !ok {
+			return result, iter.Err()
+		}
+
+		result = append(result, segment{
+			Start:    start,
+			End:      end,
+			Text:     text[start:end],
+			Status:   iter.RuleStatus(),
+			Statuses: iter.RuleStatuses(),
+		})
+
+		start = end
+	}
+}
+
+// Write the segments of a file in the provided output format.
+func write(w io.Writer, format, name string, segments []segment) error {
+	switch format {
+	case "lines":
+		for _, s := range segments {
+			if _, err := fmt.Fprintln(w, strconv.Quote(s.Text)); err != nil {
+				return err
+			}
+		}
+
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+
+		return encoder.Encode(document{Name: name, Segments: segments})
+
+	case "visual":
+		var b strings.Builder
+		b.WriteByte('|')
+
+		for _, s := range segments {
+			b.WriteString(s.Text)
+			b.WriteByte('|')
+		}
+
+		b.WriteByte('\n')
+
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	return nil
+}
+
+// Write a dump of the rules, returning the exit status.
+func writeDump(stdout, stderr io.Writer, rules *rbbi.Rules, dump string) int {
+	var err error
+
+	switch dump {
+	case "forward":
+		err = rules.WriteForwardDOT(stdout)
+	case "reverse":
+		err = rules.WriteReverseDOT(stdout)
+	case "categories":
+		err = rules.WriteCategories(stdout)
+	default:
+		fmt.Fprintf(stderr, "rbbi: unknown dump %q\n", dump)
+		return 2
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "rbbi: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+// Run the command, returning the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("rbbi", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	kind := flags.String("type", "word", "kind of break: char, word, line or sentence")
+	format := flags.String("format", "lines", "output format: lines, json or visual")
+	trace := flags.Bool("trace", false, "write the steps of the state machines to standard error")
+	dump := flags.String("dump", "", "write the rules instead of segmenting: forward, reverse or categories")
+
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: rbbi [-type char|word|line|sentence] [-format lines|json|visual] [-trace] [file ...]")
+		fmt.Fprintln(stderr, "       rbbi [-type char|word|line|sentence] -dump forward|reverse|categories")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	newRBBI, ok := constructors[*kind]
+	if !ok {
+		fmt.Fprintf(stderr, "rbbi: unknown break type %q\n", *kind)
+		return 2
+	}
+
+	switch *format {
+	case "lines", "json", "visual":
+	default:
+		fmt.Fprintf(stderr, "rbbi: unknown output format %q\n", *format)
+		return 2
+	}
+
+	if *dump != "" {
+		return writeDump(stdout, stderr, newRBBI().Rules(), *dump)
+	}
+
+	names := flags.Args()
+	if len(names) == 0 {
+		names = []string{"-"}
+	}
+
+	iter := newRBBI()
+	if *trace {
+		iter.SetTracer(rbbi.NewTextTracer(stderr))
+	}
+
+	status := 0
+
+	for _, name := range names {
+		var text []byte
+		var err error
+
+		if name == "-" {
+			text, err = io.ReadAll(stdin)
+		} else {
+			text, err = os.ReadFile(name)
+		}
+
+		if err != nil {
+			fmt.Fprintf(stderr, "rbbi: %v\n", err)
+			status = 1
+			continue
+		}
+
+		result, err := segments(iter, string(text))
+		if err != nil {
+			fmt.Fprintf(stderr, "rbbi: %v: %v\n", name, err)
+			status = 1
+			continue
+		}
+
+		if err := write(stdout, *format, name, result); err != nil {
+			fmt.Fprintf(stderr, "rbbi: %v\n", err)
+			return 1
+		}
+	}
+
+	return status
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
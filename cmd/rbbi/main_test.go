@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	rbbi "github.com/thedjinn/rbbi-go"
+)
+
+// Run the command with the provided arguments and input, and return its exit
+// status and output.
+func runCommand(input string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	status := run(args, strings.NewReader(input), &stdout, &stderr)
+
+	return status, stdout.String(), stderr.String()
+}
+
+func TestLines(t *testing.T) {
+	status, output, _ := runCommand("Hello, world!\n", "-type", "word")
+	expected := "\"Hello\"\n\",\"\n\" \"\n\"world\"\n\"!\"\n\"\\n\"\n"
+
+	if status != 0 || output != expected {
+		t.Errorf("Invalid output %q with status %v", output, status)
+	}
+}
+
+func TestVisual(t *testing.T) {
+	tests := []struct {
+		kind     string
+		input    string
+		expected string
+	}{
+		{"char", "a🏴‍☠️b", "|a|🏴‍☠️|b|\n"},
+		{"word", "Hi. Yes.", "|Hi|.| |Yes|.|\n"},
+		{"line", "Hi. Yes.", "|Hi. |Yes.|\n"},
+		{"sentence", "Hi. Yes.", "|Hi. |Yes.|\n"},
+	}
+
+	for _, test := range tests {
+		if status, output, _ := runCommand(test.input, "-type", test.kind, "-format", "visual"); status != 0 || output != test.expected {
+			t.Errorf("Invalid %v output %q with status %v", test.kind, output, status)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	status, output, _ := runCommand("ab 42", "-format", "json")
+	if status != 0 {
+		t.Fatalf("Invalid status %v", status)
+	}
+
+	var doc document
+	if err := json.Unmarshal([]byte(output), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []segment{
+		{0, 2, "ab", rbbi.RuleStatusWordLetter, []int{rbbi.RuleStatusWordLetter}},
+		{2, 3, " ", rbbi.RuleStatusWordNone, []int{rbbi.RuleStatusWordNone}},
+		{3, 5, "42", rbbi.RuleStatusWordNumber, []int{rbbi.RuleStatusWordNumber}},
+	}
+
+	if doc.Name != "-" || len(doc.Segments) != len(expected) {
+		t.Fatalf("Invalid document %+v", doc)
+	}
+
+	for i, s := range doc.Segments {
+		if s.Start != expected[i].Start || s.End != expected[i].End || s.Text != expected[i].Text || s.Status != expected[i].Status || len(s.Statuses) != 1 || s.Statuses[0] != expected[i].Statuses[0] {
+			t.Errorf("Invalid segment %+v, expected %+v", s, expected[i])
+		}
+	}
+}
+
+func TestFiles(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "input.txt")
+
+	if err := os.WriteFile(name, []byte("a b"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	status, output, _ := runCommand("c", "-format", "visual", name, "-")
+	if status != 0 || output != "|a| |b|\n|c|\n" {
+		t.Errorf("Invalid output %q with status %v", output, status)
+	}
+
+	status, _, errors := runCommand("", filepath.Join(dir, "missing.txt"))
+	if status != 1 || !strings.Contains(errors, "missing.txt") {
+		t.Errorf("Invalid status %v and error %q for a missing file", status, errors)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	status, output, trace := runCommand("a", "-trace", "-type", "char")
+	if status != 0 || output != "\"a\"\n" || !strings.Contains(trace, "U+0061") {
+		t.Errorf("Invalid output %q and trace %q with status %v", output, trace, status)
+	}
+}
+
+func TestDump(t *testing.T) {
+	for dump, prefix := range map[string]string{
+		"forward":    "digraph forward {",
+		"reverse":    "digraph reverse {",
+		"categories": "category 0 {unused}:",
+	} {
+		if status, output, _ := runCommand("", "-type", "line", "-dump", dump); status != 0 || !strings.HasPrefix(output, prefix) {
+			t.Errorf("Invalid %v dump with status %v", dump, status)
+		}
+	}
+}
+
+func TestInvalidFlags(t *testing.T) {
+	for _, args := range [][]string{{"-type", "paragraph"}, {"-format", "xml"}, {"-dump", "backward"}, {"-unknown"}} {
+		if status, _, _ := runCommand("", args...); status != 2 {
+			t.Errorf("Invalid status %v for arguments %v", status, args)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package rbbi
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// The program used to check which tables are linked, iterating over a text
+// using a single kind of break iterator.
+const deadCodeProgram = `package main
+
+import (
+	"fmt"
+
+	rbbi "github.com/thedjinn/rbbi-go"
+)
+
+func main() {
+	iter := rbbi.New%vRBBI()
+	iter.SetCursor(rbbi.NewStringCursor("Hello, world!"))
+
+	for {
+		position, ok := iter.Next()
+		if !ok {
+			break
+		}
+
+		fmt.Println(position)
+	}
+}
+`
+
+// The tables are statically initialized package variables, so the linker
+// leaves out the tables of the rule sets that a program doesn't use. This
+// breaks as soon as something that is always linked refers to all of them,
+// such as an init function or a map of the rule sets, so check the symbols of
+// a small program for every kind of break iterator.
+func TestDeadCodeElimination(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping linking programs in short mode")
+	}
+
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("The go command is not available")
+	}
+
+	module, err := filepath.Abs(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{"Character", "Line", "Sentence", "Word"}
+
+	for _, name := range names {
+		dir := t.TempDir()
+
+		goMod := fmt.Sprintf("module deadcode\n\ngo 1.18\n\nrequire github.com/thedjinn/rbbi-go v0.0.0\n\nreplace github.com/thedjinn/rbbi-go => %v\n", module)
+		if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(fmt.Sprintf(deadCodeProgram, name)), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		build := exec.Command(goTool, "build", "-o", "program")
+		build.Dir = dir
+		if output, err := build.CombinedOutput(); err != nil {
+			t.Fatalf("Building the program for %v breaks failed: %v\n%s", name, err, output)
+		}
+
+		nm := exec.Command(goTool, "tool", "nm", "program")
+		nm.Dir = dir
+		output, err := nm.Output()
+		if err != nil {
+			t.Fatalf("Listing the symbols of the program for %v breaks failed: %v", name, err)
+		}
+
+		symbols := make(map[string]bool)
+		for _, line := range strings.Split(string(output), "\n") {
+			if fields := strings.Fields(line); len(fields) > 0 {
+				symbols[fields[len(fields)-1]] = true
+			}
+		}
+
+		for _, other := range names {
+			symbol := "github.com/thedjinn/rbbi-go.rbbi" + other + "Data"
+			if symbols[symbol] != (other == name) {
+				t.Errorf("The program for %v breaks links %v: %v", name, symbol, symbols[symbol])
+			}
+		}
+
+		if symbols["github.com/thedjinn/rbbi-go.widthTrie"] {
+			t.Errorf("The program for %v breaks links the width tables", name)
+		}
+	}
+}
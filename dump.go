@@ -0,0 +1,222 @@
+package rbbi
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// The maximum number of ranges used to represent a category in the DOT
+// graphs.
+const dumpRepresentativeRanges = 8
+
+// Format a rune for a dump, using an escape sequence when it's not a visible
+// character or when it's one of the special characters. Quotes and
+// backslashes are always escaped, so that the result can be used in DOT
+// labels.
+func formatDumpRune(c rune, special string) string {
+	if unicode.IsGraphic(c) && !unicode.IsSpace(c) && !unicode.Is(unicode.Mn, c) && !strings.ContainsRune(special+"\"\\", c) {
+		return string(c)
+	}
+
+	if c > 0xFFFF {
+		return fmt.Sprintf("\\U%08X", c)
+	}
+
+	return fmt.Sprintf("\\u%04X", c)
+}
+
+// Return a short character class representing the code points of a
+// category, consisting of its first ranges.
+func representative(ranges []CategoryRange) string {
+	var b strings.Builder
+	b.WriteByte('[')
+
+	for i, cr := range ranges {
+		if i == dumpRepresentativeRanges {
+			b.WriteString("…")
+			break
+		}
+
+		b.WriteString(formatDumpRune(cr.Lo, "-[]"))
+		if cr.Hi > cr.Lo {
+			if cr.Hi > cr.Lo+1 {
+				b.WriteByte('-')
+			}
+
+			b.WriteString(formatDumpRune(cr.Hi, "-[]"))
+		}
+	}
+
+	b.WriteByte(']')
+	return b.String()
+}
+
+// Format a sorted list of categories, combining consecutive categories into
+// ranges such as "3-5".
+func formatCategories(categories []int) string {
+	var parts []string
+
+	for i := 0; i < len(categories); {
+		j := i
+		for j+1 < len(categories) && categories[j+1] == categories[j]+1 {
+			j++
+		}
+
+		if j > i {
+			parts = append(parts, fmt.Sprintf("%v-%v", categories[i], categories[j]))
+		} else {
+			parts = append(parts, fmt.Sprint(categories[i]))
+		}
+
+		i = j + 1
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Write the forward state table as a Graphviz DOT graph. See WriteReverseDOT()
+// for the format of the graph.
+func (r *Rules) WriteForwardDOT(w io.Writer) error {
+	return r.writeDOT(w, "forward", false)
+}
+
+// Write the safe reverse state table as a Graphviz DOT graph. Every state is
+// a node, labelled with its accepting and lookahead values and the rule
+// statuses of its tag. The transitions between two states are combined into
+// a single edge, labelled with the categories that cause them. A legend node
+// lists the name and a representative set of characters for each category,
+// and the pseudo categories for the start and end of the text.
+func (r *Rules) WriteReverseDOT(w io.Writer) error {
+	return r.writeDOT(w, "reverse", true)
+}
+
+// Write the forward or the safe reverse state table as a Graphviz DOT graph
+// with the provided name.
+func (r *Rules) writeDOT(w io.Writer, name string, reverse bool) error {
+	if err := r.validate(); err != nil {
+		return err
+	}
+
+	table := &r.data.forwardTable
+	if reverse {
+		table = &r.data.reverseTable
+	}
+
+	ranges, err := r.categoryRanges()
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %v {\n", name)
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=circle, fontname=\"monospace\"];\n")
+	b.WriteString("\tedge [fontname=\"monospace\"];\n\n")
+
+	// The legend of the categories
+	b.WriteString("\tlegend [shape=box, label=\"categories\\l")
+	for category, categoryRanges := range ranges {
+		label := r.CategoryName(category)
+		if category > 2 {
+			label += " " + representative(categoryRanges)
+		}
+
+		fmt.Fprintf(&b, "%v: %v\\l", category, label)
+	}
+	b.WriteString("\"];\n\n")
+
+	rowLength := int(table.rowLength)
+	for state := 0; state < int(table.stateCount); state++ {
+		row := state * rowLength
+
+		label := fmt.Sprintf("%v", state)
+		switch state {
+		case int(rbbiStateStop):
+			label += "\\nstop"
+		case int(rbbiStateStart):
+			label += "\\nstart"
+		}
+
+		shape := "circle"
+		if accepting := table.value(row + rbbiRowAccepting); accepting != 0 {
+			label += fmt.Sprintf("\\naccept %v", accepting)
+			shape = "doublecircle"
+		}
+
+		if lookahead := table.value(row + rbbiRowLookahead); lookahead != 0 {
+			label += fmt.Sprintf("\\nlookahead %v", lookahead)
+		}
+
+		if tag := table.value(row + rbbiRowTagIndex); tag != 0 {
+			count := int(r.data.ruleStatusTable[tag])
+			label += fmt.Sprintf("\\ntag %v %v", tag, r.data.ruleStatusTable[tag+1:tag+1+count])
+		}
+
+		fmt.Fprintf(&b, "\t%v [shape=%v, label=\"%v\"];\n", state, shape, label)
+	}
+
+	b.WriteByte('\n')
+
+	// Skip the stop state, which has no transitions
+	for state := 1; state < int(table.stateCount); state++ {
+		row := state * rowLength
+
+		var targets []int
+		categories := make(map[int][]int)
+
+		for category := 0; category < int(r.data.categoryCount); category++ {
+			next := table.value(row + rbbiRowNextStates + category)
+			if _, ok := categories[next]; !ok {
+				targets = append(targets, next)
+			}
+
+			categories[next] = append(categories[next], category)
+		}
+
+		for _, next := range targets {
+			fmt.Fprintf(&b, "\t%v -> %v [label=\"%v\"];\n", state, next, formatCategories(categories[next]))
+		}
+	}
+
+	b.WriteString("}\n")
+
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// Write the code point ranges of every category as text. Each category is
+// written on a header line with its name and number of code points, followed
+// by a line for every range.
+func (r *Rules) WriteCategories(w io.Writer) error {
+	if err := r.validate(); err != nil {
+		return err
+	}
+
+	ranges, err := r.categoryRanges()
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	for category, categoryRanges := range ranges {
+		count := 0
+		for _, cr := range categoryRanges {
+			count += int(cr.Hi-cr.Lo) + 1
+		}
+
+		fmt.Fprintf(&b, "category %v %v: %v code points in %v ranges\n", category, r.CategoryName(category), count, len(categoryRanges))
+
+		for _, cr := range categoryRanges {
+			if cr.Lo == cr.Hi {
+				fmt.Fprintf(&b, "\t%U\t%v\n", cr.Lo, formatDumpRune(cr.Lo, ""))
+			} else {
+				fmt.Fprintf(&b, "\t%U..%U\t%v..%v\n", cr.Lo, cr.Hi, formatDumpRune(cr.Lo, ""), formatDumpRune(cr.Hi, ""))
+			}
+		}
+	}
+
+	_, err = io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,182 @@
+package rbbi
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Parse the edges of a DOT graph and rebuild the transitions of the state
+// table from them.
+func parseDOTEdges(dot string, stateCount, categoryCount int) [][]int {
+	transitions := make([][]int, stateCount)
+	for state := range transitions {
+		transitions[state] = make([]int, categoryCount)
+		for category := range transitions[state] {
+			transitions[state][category] = -1
+		}
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(dot))
+	for scanner.Scan() {
+		var from, to int
+		var label string
+
+		line := strings.TrimSpace(scanner.Text())
+		if n, _ := fmt.Sscanf(line, "%d -> %d [label=%q];", &from, &to, &label); n != 3 {
+			continue
+		}
+
+		for _, part := range strings.Fields(label) {
+			first, last := part, part
+			if i := strings.IndexByte(part, '-'); i >= 0 {
+				first, last = part[:i], part[i+1:]
+			}
+
+			start, _ := strconv.Atoi(first)
+			end, _ := strconv.Atoi(last)
+
+			for category := start; category <= end; category++ {
+				transitions[from][category] = to
+			}
+		}
+	}
+
+	return transitions
+}
+
+func TestFormatDumpRune(t *testing.T) {
+	cases := map[rune]string{
+		'a':          "a",
+		'-':          `\u002D`,
+		'"':          `\u0022`,
+		'\n':         `\u000A`,
+		'\u0300':     `\u0300`,
+		'\U0001F600': "\U0001F600",
+		'\U000E0001': `\U000E0001`,
+	}
+
+	for c, expected := range cases {
+		if formatted := formatDumpRune(c, "-"); formatted != expected {
+			t.Errorf("Invalid formatting %q of %U, expected %q", formatted, c, expected)
+		}
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	for _, rules := range []*Rules{CharacterRules(), LineRules(), SentenceRules(), WordRules()} {
+		for name, write := range map[string]func(*Rules) (string, *rbbiStateTable){
+			"forward": func(r *Rules) (string, *rbbiStateTable) {
+				var b strings.Builder
+				if err := r.WriteForwardDOT(&b); err != nil {
+					t.Fatal(err)
+				}
+
+				return b.String(), &r.data.forwardTable
+			},
+			"reverse": func(r *Rules) (string, *rbbiStateTable) {
+				var b strings.Builder
+				if err := r.WriteReverseDOT(&b); err != nil {
+					t.Fatal(err)
+				}
+
+				return b.String(), &r.data.reverseTable
+			},
+		} {
+			dot, table := write(rules)
+
+			if !strings.HasPrefix(dot, "digraph "+name+" {\n") || !strings.HasSuffix(dot, "}\n") {
+				t.Fatalf("Invalid %v graph\n%v", name, dot)
+			}
+
+			if !strings.Contains(dot, `1: {eof}\l2: {bof}\l`) {
+				t.Errorf("The %v graph has no legend", name)
+			}
+
+			categoryCount := int(rules.data.categoryCount)
+			transitions := parseDOTEdges(dot, int(table.stateCount), categoryCount)
+
+			// The stop state has no edges
+			for state := 1; state < int(table.stateCount); state++ {
+				for category := 0; category < categoryCount; category++ {
+					expected := table.value(state*int(table.rowLength) + rbbiRowNextStates + category)
+					if transitions[state][category] != expected {
+						t.Fatalf("Invalid %v transition %v for state %v and category %v", name, transitions[state][category], state, category)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestWriteDOTLabels(t *testing.T) {
+	var b strings.Builder
+	if err := WordRules().WriteForwardDOT(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	// The category of letters, and a state that accepts words containing
+	// letters
+	category, _ := wordRules.data.trie.get('a')
+	if !strings.Contains(b.String(), fmt.Sprintf(`\l%v: ALetter [`, category)) || !strings.Contains(b.String(), "a-z") {
+		t.Error("The legend does not show the letters")
+	}
+
+	if !strings.Contains(b.String(), "shape=doublecircle") || !strings.Contains(b.String(), `\ntag `) || !strings.Contains(b.String(), "[200]") {
+		t.Error("The accepting states and their tags are not shown")
+	}
+}
+
+func TestWriteCategories(t *testing.T) {
+	var b strings.Builder
+	if err := LineRules().WriteCategories(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	total := 0
+	category := -1
+
+	scanner := bufio.NewScanner(strings.NewReader(b.String()))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if !strings.HasPrefix(line, "\t") {
+			var count, ranges int
+			var name string
+			if n, _ := fmt.Sscanf(line, "category %d %s %d code points in %d ranges", &category, &name, &count, &ranges); n != 4 || name != LineRules().CategoryName(category)+":" {
+				t.Fatalf("Invalid category line %q", line)
+			}
+
+			total += count
+			continue
+		}
+
+		var start, end rune
+		fields := strings.Fields(line)
+		if n, _ := fmt.Sscanf(fields[0], "U+%X..U+%X", &start, &end); n == 1 {
+			end = start
+		}
+
+		for _, c := range []rune{start, end} {
+			if value, _ := lineRules.data.trie.get(c); int(value) != category {
+				t.Errorf("Rune %U is listed in category %v instead of %v", c, category, value)
+			}
+		}
+	}
+
+	if total != int(ucpTrieMaxUnicode)+1 {
+		t.Errorf("The categories contain %v code points", total)
+	}
+}
+
+func TestWriteDOTCorrupt(t *testing.T) {
+	data := copyData(decodedData(&wordRules))
+	setNextStates(&data.forwardTable, int(rbbiStateStart), 200)
+
+	var b strings.Builder
+	if err := (&Rules{data: data}).WriteForwardDOT(&b); err == nil || b.Len() != 0 {
+		t.Error("A corrupt table was written")
+	}
+}
@@ -0,0 +1,229 @@
+// Package editing implements the caret movement, selection and deletion
+// operations of a text editor on top of the rule-based break iterators. All
+// positions are byte offsets into the text.
+//
+// A caret offset in the middle of the UTF-8 encoding of a rune is moved to the
+// start of that rune, as by rbbi.AlignmentSnapBackward, and an offset outside
+// the text is moved to its start or end. The results of every function are
+// therefore always at a rune boundary within the text. When the break iterator
+// fails, which only happens when its tables are corrupt, the caret doesn't
+// move, selections are empty and nothing is deleted.
+package editing
+
+import (
+	rbbi "github.com/thedjinn/rbbi-go"
+)
+
+// The policy for caret offsets that are in the middle of a rune.
+const caretAlignment = rbbi.AlignmentSnapBackward
+
+// Instantiate a break iterator for a text.
+func newIterator(iter *rbbi.RBBI, text string) *rbbi.RBBI {
+	cursor := rbbi.NewStringCursor(text)
+	cursor.SetAlignmentPolicy(caretAlignment)
+
+	iter.SetCursor(cursor)
+	return iter
+}
+
+// Return a caret offset moved into the text and to a rune boundary.
+func caret(text string, offset int) int {
+	if offset <= 0 {
+		return 0
+	}
+
+	if offset >= len(text) {
+		return len(text)
+	}
+
+	cursor := rbbi.NewStringCursor(text)
+	cursor.SetAlignmentPolicy(caretAlignment)
+
+	// The offset is within the text, so the snapping Cursor accepts it
+	cursor.SetPosition(offset)
+	return cursor.Position()
+}
+
+// Return the caret position after moving right by one grapheme cluster. The
+// end of the text is returned when the caret is already at the end.
+func NextGrapheme(text string, offset int) int {
+	return following(newIterator(rbbi.NewCharacterRBBI(), text), text, caret(text, offset))
+}
+
+// Return the caret position after moving left by one grapheme cluster. The
+// start of the text is returned when the caret is already at the start.
+func PreviousGrapheme(text string, offset int) int {
+	return preceding(newIterator(rbbi.NewCharacterRBBI(), text), caret(text, offset))
+}
+
+// Return the caret position after moving right by one word. The caret skips
+// over white space and punctuation, and stops at the end of the first word
+// it encounters. The end of the text is returned when there are no more words.
+func NextWord(text string, offset int) int {
+	offset = caret(text, offset)
+	iter := newIterator(rbbi.NewWordRBBI(), text)
+
+	for position := offset; ; {
+		end, ok := iter.Following(position)
+		if !ok {
+			if iter.Err() != nil {
+				return offset
+			}
+
+			return len(text)
+		}
+
+		if isWord(iter.RuleStatus()) {
+			return end
+		}
+
+		position = end
+	}
+}
+
+// Return the caret position after moving left by one word. The caret skips
+// over white space and punctuation, and stops at the start of the first word
+// it encounters. The start of the text is returned when there are no more
+// words.
+func PreviousWord(text string, offset int) int {
+	offset = caret(text, offset)
+	iter := newIterator(rbbi.NewWordRBBI(), text)
+
+	for position := offset; ; {
+		start, ok := iter.Preceding(position)
+		if !ok {
+			if iter.Err() != nil {
+				return offset
+			}
+
+			return 0
+		}
+
+		// The rule status of a break describes the text preceding it, so
+		// move forward to the end of the segment to find out what it
+		// contains.
+		if _, ok := iter.Following(start); !ok {
+			return offset
+		}
+
+		if isWord(iter.RuleStatus()) {
+			return start
+		}
+
+		position = start
+	}
+}
+
+// Return the caret position after moving right by one sentence.
+func NextSentence(text string, offset int) int {
+	return following(newIterator(rbbi.NewSentenceRBBI(), text), text, caret(text, offset))
+}
+
+// Return the caret position after moving left by one sentence.
+func PreviousSentence(text string, offset int) int {
+	return preceding(newIterator(rbbi.NewSentenceRBBI(), text), caret(text, offset))
+}
+
+// Return the range of the word segment containing the provided offset, as
+// selected by double-clicking. Note that the segment may consist of white
+// space or punctuation when the offset is not within a word.
+func WordAt(text string, offset int) (start, end int) {
+	return segmentAt(newIterator(rbbi.NewWordRBBI(), text), text, caret(text, offset))
+}
+
+// Return the range of the sentence containing the provided offset, as
+// selected by triple-clicking.
+func SentenceAt(text string, offset int) (start, end int) {
+	return segmentAt(newIterator(rbbi.NewSentenceRBBI(), text), text, caret(text, offset))
+}
+
+// Return the range of the paragraph containing the provided offset. Paragraphs
+// are delimited by hard line breaks, and include their trailing line
+// separator. An offset at the end of a text that ends in a line separator
+// selects the last paragraph.
+func ParagraphAt(text string, offset int) (start, end int) {
+	offset = caret(text, offset)
+	iter := newIterator(rbbi.NewLineRBBI(), text)
+
+	for {
+		breakpoint, ok := iter.Next()
+		if !ok {
+			if iter.Err() != nil {
+				return offset, offset
+			}
+
+			return start, len(text)
+		}
+
+		if iter.RuleStatus() < rbbi.RuleStatusLineHard {
+			continue
+		}
+
+		if breakpoint > offset || breakpoint == len(text) {
+			return start, breakpoint
+		}
+
+		start = breakpoint
+	}
+}
+
+// Delete the word before the caret, along with any white space and
+// punctuation between it and the caret. Returns the new text and the new
+// caret position.
+func DeleteWordBackward(text string, offset int) (string, int) {
+	offset = caret(text, offset)
+	start := PreviousWord(text, offset)
+	return text[:start] + text[offset:], start
+}
+
+// Delete the word after the caret, along with any white space and punctuation
+// between it and the caret. Returns the new text and the new caret position.
+func DeleteWordForward(text string, offset int) (string, int) {
+	offset = caret(text, offset)
+	end := NextWord(text, offset)
+	return text[:offset] + text[end:], offset
+}
+
+// Return whether a word break rule status indicates that the preceding segment
+// is a word, as opposed to white space or punctuation.
+func isWord(status int) bool {
+	return status >= rbbi.RuleStatusWordNoneLimit
+}
+
+// Return the first break following the offset, or the end of the text. The
+// offset itself is returned when the break iterator fails.
+func following(iter *rbbi.RBBI, text string, offset int) int {
+	if breakpoint, ok := iter.Following(offset); ok {
+		return breakpoint
+	} else if iter.Err() != nil {
+		return offset
+	}
+
+	return len(text)
+}
+
+// Return the last break preceding the offset, or the start of the text. The
+// offset itself is returned when the break iterator fails.
+func preceding(iter *rbbi.RBBI, offset int) int {
+	if breakpoint, ok := iter.Preceding(offset); ok {
+		return breakpoint
+	} else if iter.Err() != nil {
+		return offset
+	}
+
+	return 0
+}
+
+// Return the range of the segment containing the offset. An offset at a break
+// selects the segment following it, unless it is the end of the text. An empty
+// range at the offset is returned when the break iterator fails.
+func segmentAt(iter *rbbi.RBBI, text string, offset int) (start, end int) {
+	end = following(iter, text, offset)
+	start = preceding(iter, end)
+
+	if iter.Err() != nil {
+		return offset, offset
+	}
+
+	return start, end
+}
@@ -0,0 +1,174 @@
+package editing
+
+import (
+	"testing"
+)
+
+const text = "Hello, wörld! How are you?\nFine 🐨 thanks."
+
+func TestGraphemeMovement(t *testing.T) {
+	if offset := NextGrapheme(text, 0); offset != 1 {
+		t.Errorf("Invalid next grapheme offset %v", offset)
+	}
+
+	if offset := NextGrapheme(text, 33); offset != 37 {
+		t.Errorf("Invalid next grapheme offset %v over emoji", offset)
+	}
+
+	if offset := PreviousGrapheme(text, 37); offset != 33 {
+		t.Errorf("Invalid previous grapheme offset %v over emoji", offset)
+	}
+
+	if offset := NextGrapheme(text, len(text)); offset != len(text) {
+		t.Errorf("Invalid next grapheme offset %v at end", offset)
+	}
+
+	if offset := PreviousGrapheme(text, 0); offset != 0 {
+		t.Errorf("Invalid previous grapheme offset %v at start", offset)
+	}
+}
+
+func TestWordMovement(t *testing.T) {
+	if offset := NextWord(text, 0); offset != 5 {
+		t.Errorf("Invalid next word offset %v", offset)
+	}
+
+	if offset := NextWord(text, 5); offset != 13 {
+		t.Errorf("Invalid next word offset %v skipping punctuation", offset)
+	}
+
+	if offset := NextWord(text, 10); offset != 13 {
+		t.Errorf("Invalid next word offset %v from within word", offset)
+	}
+
+	// Offset 9 is in the middle of the ö, and is moved to its start
+	if offset := NextWord(text, 9); offset != 13 {
+		t.Errorf("Invalid next word offset %v from within a rune", offset)
+	}
+
+	if offset := PreviousWord(text, 9); offset != 7 {
+		t.Errorf("Invalid previous word offset %v from within a rune", offset)
+	}
+
+	if offset := PreviousWord(text, 13); offset != 7 {
+		t.Errorf("Invalid previous word offset %v", offset)
+	}
+
+	if offset := PreviousWord(text, 7); offset != 0 {
+		t.Errorf("Invalid previous word offset %v skipping punctuation", offset)
+	}
+
+	if offset := PreviousWord(text, 3); offset != 0 {
+		t.Errorf("Invalid previous word offset %v from within word", offset)
+	}
+
+	if offset := NextWord(text, 45); offset != len(text) {
+		t.Errorf("Invalid next word offset %v at end", offset)
+	}
+}
+
+func TestSentenceMovement(t *testing.T) {
+	if offset := NextSentence(text, 0); offset != 15 {
+		t.Errorf("Invalid next sentence offset %v", offset)
+	}
+
+	if offset := NextSentence(text, 15); offset != 28 {
+		t.Errorf("Invalid next sentence offset %v", offset)
+	}
+
+	if offset := PreviousSentence(text, 20); offset != 15 {
+		t.Errorf("Invalid previous sentence offset %v", offset)
+	}
+}
+
+func TestSelection(t *testing.T) {
+	if start, end := WordAt(text, 10); start != 7 || end != 13 {
+		t.Errorf("Invalid word selection %v-%v", start, end)
+	}
+
+	if start, end := WordAt(text, len(text)); start != len(text)-1 || end != len(text) {
+		t.Errorf("Invalid word selection %v-%v at end", start, end)
+	}
+
+	if start, end := SentenceAt(text, 20); start != 15 || end != 28 {
+		t.Errorf("Invalid sentence selection %v-%v", start, end)
+	}
+
+	if start, end := ParagraphAt(text, 5); start != 0 || end != 28 {
+		t.Errorf("Invalid paragraph selection %v-%v", start, end)
+	}
+
+	if start, end := ParagraphAt(text, 30); start != 28 || end != len(text) {
+		t.Errorf("Invalid paragraph selection %v-%v", start, end)
+	}
+
+	// The end of a text ending in a line separator is in the last paragraph
+	lines := "First\nSecond\n"
+	if start, end := ParagraphAt(lines, len(lines)); start != 6 || end != len(lines) {
+		t.Errorf("Invalid paragraph selection %v-%v at the end", start, end)
+	}
+}
+
+func TestDeleteWord(t *testing.T) {
+	if result, offset := DeleteWordBackward(text, 13); result != "Hello, ! How are you?\nFine 🐨 thanks." || offset != 7 {
+		t.Errorf("Invalid backward deletion %q at %v", result, offset)
+	}
+
+	if result, offset := DeleteWordForward(text, 5); result != "Hello! How are you?\nFine 🐨 thanks." || offset != 5 {
+		t.Errorf("Invalid forward deletion %q at %v", result, offset)
+	}
+}
+
+func TestOutOfRange(t *testing.T) {
+	// Offsets outside the text are moved to its start or end
+	if offset := NextWord(text, -5); offset != 5 {
+		t.Errorf("Invalid next word offset %v before the start", offset)
+	}
+
+	if offset := PreviousWord(text, len(text)+5); offset != 38 {
+		t.Errorf("Invalid previous word offset %v after the end", offset)
+	}
+
+	if offset := NextGrapheme(text, len(text)+5); offset != len(text) {
+		t.Errorf("Invalid next grapheme offset %v after the end", offset)
+	}
+
+	if start, end := WordAt(text, -1); start != 0 || end != 5 {
+		t.Errorf("Invalid word selection %v-%v before the start", start, end)
+	}
+
+	if result, offset := DeleteWordBackward(text, len(text)+5); result != "Hello, wörld! How are you?\nFine 🐨 " || offset != 38 {
+		t.Errorf("Invalid backward deletion %q at %v after the end", result, offset)
+	}
+
+	if result, offset := DeleteWordForward(text, -5); result != ", wörld! How are you?\nFine 🐨 thanks." || offset != 0 {
+		t.Errorf("Invalid forward deletion %q at %v before the start", result, offset)
+	}
+}
+
+func TestMisaligned(t *testing.T) {
+	// Offsets in the middle of a rune are moved to its start
+	if offset := NextGrapheme(text, 35); offset != 37 {
+		t.Errorf("Invalid next grapheme offset %v from within emoji", offset)
+	}
+
+	if offset := PreviousGrapheme(text, 9); offset != 7 {
+		t.Errorf("Invalid previous grapheme offset %v from within a rune", offset)
+	}
+
+	if start, end := WordAt(text, 9); start != 7 || end != 13 {
+		t.Errorf("Invalid word selection %v-%v from within a rune", start, end)
+	}
+
+	if result, offset := DeleteWordBackward(text, 9); result != "Hello, örld! How are you?\nFine 🐨 thanks." || offset != 7 {
+		t.Errorf("Invalid backward deletion %q at %v from within a rune", result, offset)
+	}
+
+	if result, offset := DeleteWordForward(text, 9); result != "Hello, w! How are you?\nFine 🐨 thanks." || offset != 8 {
+		t.Errorf("Invalid forward deletion %q at %v from within a rune", result, offset)
+	}
+
+	if result, offset := DeleteWordForward(text, 35); result != "Hello, wörld! How are you?\nFine ." || offset != 33 {
+		t.Errorf("Invalid forward deletion %q at %v from within emoji", result, offset)
+	}
+}
@@ -0,0 +1,69 @@
+package rbbi
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// The ErrCorruptTable error is reported when the break iterator
+	// encounters inconsistent data in its state tables, trie, or rule status
+	// table. Errors wrapping it describe which part of the data is corrupt.
+	ErrCorruptTable = errors.New("Corrupt break iterator table")
+
+	// The ErrCursor error is reported when a Cursor does not behave as
+	// required by the Cursor interface, e.g. when it refuses to return to a
+	// position it reported earlier or when it does not advance while
+	// yielding a rune. Errors wrapping it describe what went wrong.
+	ErrCursor = errors.New("Inconsistent Cursor behavior")
+)
+
+// Return an error wrapping ErrCorruptTable with a formatted description.
+func corruptTableError(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrCorruptTable, fmt.Sprintf(format, args...))
+}
+
+// Return an error wrapping ErrCursor with a formatted description.
+func cursorError(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrCursor, fmt.Sprintf(format, args...))
+}
+
+var (
+	// The ErrPositionOutOfRange error is returned by the Cursor
+	// implementations of this package and by OffsetMap when a position is
+	// outside the text.
+	ErrPositionOutOfRange = errors.New("Position is outside the text")
+
+	// The ErrMisalignedPosition error is returned by the Cursor
+	// implementations of this package when a position is not at a rune
+	// boundary, and their AlignmentPolicy is AlignmentReject. OffsetMap
+	// returns it for offsets that are not at a boundary of the unit they are
+	// converted to, such as between the code units of a UTF-16 surrogate
+	// pair.
+	ErrMisalignedPosition = errors.New("Position is not at a rune boundary")
+)
+
+// The CursorError is reported by the break iterator when a Cursor refuses a
+// position. It wraps the error returned by the Cursor, and also matches
+// ErrCursor when tested using errors.Is().
+type CursorError struct {
+	// The position that was refused
+	Position int
+
+	// The error returned by the Cursor
+	Err error
+}
+
+func (e *CursorError) Error() string {
+	return fmt.Sprintf("%v: position %v was refused: %v", ErrCursor, e.Position, e.Err)
+}
+
+// Return the error returned by the Cursor.
+func (e *CursorError) Unwrap() error {
+	return e.Err
+}
+
+// Return whether the target is ErrCursor.
+func (e *CursorError) Is(target error) bool {
+	return target == ErrCursor
+}
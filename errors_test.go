@@ -0,0 +1,310 @@
+package rbbi
+
+import (
+	"errors"
+	"testing"
+)
+
+// Return a deep copy of break iterator data, which can be broken without
+// affecting the shared rules.
+func copyData(data *rbbiData) *rbbiData {
+	copyTable := func(table rbbiStateTable) rbbiStateTable {
+		table.table8 = append([]uint8{}, table.table8...)
+		return table
+	}
+
+	broken := *data
+	broken.forwardTable = copyTable(data.forwardTable)
+	broken.reverseTable = copyTable(data.reverseTable)
+	broken.ruleStatusTable = append([]int32{}, data.ruleStatusTable...)
+	broken.trie.index = append([]uint16{}, data.trie.index...)
+
+	return &broken
+}
+
+// Return the decoded tables of the rules.
+func decodedData(rules *Rules) *rbbiData {
+	if err := rules.validate(); err != nil {
+		panic(err)
+	}
+
+	return rules.data
+}
+
+// Set all transitions of a state of a state table to the provided state.
+func setNextStates(table *rbbiStateTable, state int, next uint8) {
+	row := state * int(table.rowLength)
+
+	for i := row + rbbiRowNextStates; i < row+int(table.rowLength); i++ {
+		table.table8[i] = next
+	}
+}
+
+// Return a break iterator using broken data for the provided string.
+func newBrokenRBBI(data *rbbiData, str string) *RBBI {
+	iter := (&Rules{data: data}).NewRBBI()
+	iter.SetCursor(NewStringCursor(str))
+
+	return iter
+}
+
+func expectError(t *testing.T, iter *RBBI, target error) {
+	t.Helper()
+
+	if !errors.Is(iter.Err(), target) {
+		t.Errorf("Expected error %v, got %v", target, iter.Err())
+	}
+
+	// Iteration stays stopped after an error
+	if _, ok := iter.Next(); ok {
+		t.Error("Next was ok after an error")
+	}
+}
+
+func TestErrorCorruptState(t *testing.T) {
+	data := copyData(decodedData(&characterRules))
+	setNextStates(&data.forwardTable, 1, 200)
+
+	iter := newBrokenRBBI(data, "a")
+	if pos, ok := iter.Next(); ok || pos != -1 {
+		t.Errorf("Next was ok with a corrupt state table at %v", pos)
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+}
+
+func TestErrorCorruptCategory(t *testing.T) {
+	data := copyData(decodedData(&wordRules))
+	data.categoryCount = 4
+
+	iter := newBrokenRBBI(data, "hello")
+	if _, ok := iter.Next(); ok {
+		t.Error("Next was ok with a corrupt category count")
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+}
+
+func TestErrorCorruptRuleStatus(t *testing.T) {
+	data := copyData(decodedData(&wordRules))
+	data.ruleStatusTable = data.ruleStatusTable[:1]
+
+	iter := newBrokenRBBI(data, "hello")
+	if _, ok := iter.Next(); ok {
+		t.Error("Next was ok with a corrupt rule status table")
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+
+	if iter.RuleStatus() != 0 || iter.RuleStatuses() != nil {
+		t.Error("Corrupt rule status table gave rule statuses")
+	}
+}
+
+func TestErrorCorruptLookahead(t *testing.T) {
+	data := copyData(decodedData(&characterRules))
+	for state := 0; state < int(data.forwardTable.stateCount); state++ {
+		data.forwardTable.table8[state*int(data.forwardTable.rowLength)+rbbiRowAccepting] = 100
+	}
+
+	iter := newBrokenRBBI(data, "hello")
+	if _, ok := iter.Next(); ok {
+		t.Error("Next was ok with a corrupt lookahead result")
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+}
+
+func TestErrorCorruptLookaheadResultsSize(t *testing.T) {
+	data := copyData(decodedData(&characterRules))
+	data.forwardTable.lookaheadResultsSize = 0x7fffffff
+
+	// The lookahead results of corrupt tables are not allocated
+	iter := newBrokenRBBI(data, "hello")
+	if len(iter.lookaheadMatches) != 0 {
+		t.Errorf("Allocated %v lookahead results for a corrupt table", len(iter.lookaheadMatches))
+	}
+
+	if _, ok := iter.Next(); ok {
+		t.Error("Next was ok with a corrupt lookahead results size")
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+}
+
+func TestErrorCorruptStateCount(t *testing.T) {
+	data := copyData(decodedData(&characterRules))
+
+	// The row length of 24 is a multiple of 8, so the product of the state
+	// count and the row length overflows 32 bits to the actual size of the
+	// table
+	data.forwardTable.stateCount += 1 << 29
+
+	iter := newBrokenRBBI(data, "hello")
+	if _, ok := iter.Next(); ok {
+		t.Error("Next was ok with a corrupt state count")
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+}
+
+func TestErrorCorruptTrie(t *testing.T) {
+	data := copyData(decodedData(&characterRules))
+	data.trie.index = data.trie.index[:ucpTrieBmpIndexLength]
+
+	// Code points below U+10000 do not use the truncated part of the index
+	iter := newBrokenRBBI(data, "ab🐨")
+	if pos, ok := iter.Next(); !ok || pos != 1 {
+		t.Errorf("Invalid next break position %v", pos)
+	}
+
+	if pos, ok := iter.Next(); ok {
+		t.Errorf("Next was ok with a corrupt trie at %v", pos)
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+}
+
+func TestErrorCorruptReverseTable(t *testing.T) {
+	data := copyData(decodedData(&characterRules))
+	setNextStates(&data.reverseTable, 1, 200)
+
+	iter := newBrokenRBBI(data, "hello")
+	if _, ok := iter.Preceding(3); ok {
+		t.Error("Preceding was ok with a corrupt reverse table")
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+}
+
+func TestErrorClearedBySetCursor(t *testing.T) {
+	data := copyData(decodedData(&characterRules))
+	data.categoryCount = 0
+
+	iter := newBrokenRBBI(data, "a")
+	iter.Next()
+
+	if iter.Err() == nil {
+		t.Fatal("Expected an error")
+	}
+
+	iter.SetCursor(NewStringCursor(""))
+	if iter.Err() != nil {
+		t.Error("Error was not cleared by SetCursor")
+	}
+}
+
+func TestNoErrorAtEnd(t *testing.T) {
+	iter := NewWordRBBI()
+	iter.SetCursor(NewStringCursor("hello world"))
+
+	for {
+		if _, ok := iter.Next(); !ok {
+			break
+		}
+	}
+
+	if iter.Err() != nil {
+		t.Errorf("Unexpected error %v", iter.Err())
+	}
+}
+
+// A Cursor that refuses to go back to any position after it has been moved.
+type refusingCursor struct {
+	StringCursor
+}
+
+func (c *refusingCursor) SetPosition(position int) error {
+	if position != c.position {
+		return errors.New("Refused")
+	}
+
+	return nil
+}
+
+// A Cursor that yields runes without moving.
+type stuckCursor struct {
+	StringCursor
+}
+
+func (c *stuckCursor) Next() (rune, bool) {
+	return 'a', true
+}
+
+func (c *stuckCursor) Previous() (rune, bool) {
+	return 'a', true
+}
+
+// A Cursor that can't move back once it has moved forward.
+type oneWayCursor struct {
+	StringCursor
+	movedForward bool
+}
+
+func (c *oneWayCursor) Next() (rune, bool) {
+	c.movedForward = true
+	return c.StringCursor.Next()
+}
+
+func (c *oneWayCursor) Previous() (rune, bool) {
+	if c.movedForward {
+		return -1, false
+	}
+
+	return c.StringCursor.Previous()
+}
+
+func TestErrorRefusingCursor(t *testing.T) {
+	iter := NewCharacterRBBI()
+	iter.SetCursor(&refusingCursor{StringCursor{text: "héllo"}})
+
+	if _, ok := iter.Following(2); ok {
+		t.Error("Following was ok with a refusing Cursor")
+	}
+
+	expectError(t, iter, ErrCursor)
+}
+
+func TestErrorStuckCursorNext(t *testing.T) {
+	iter := NewCharacterRBBI()
+	iter.SetCursor(&stuckCursor{StringCursor{text: "hello"}})
+
+	if _, ok := iter.Next(); ok {
+		t.Error("Next was ok with a stuck Cursor")
+	}
+
+	expectError(t, iter, ErrCursor)
+}
+
+func TestErrorStuckCursorPrevious(t *testing.T) {
+	iter := NewCharacterRBBI()
+	iter.SetCursor(&stuckCursor{StringCursor{text: "hello", position: 3}})
+
+	if _, ok := iter.Previous(); ok {
+		t.Error("Previous was ok with a stuck Cursor")
+	}
+
+	expectError(t, iter, ErrCursor)
+}
+
+func TestErrorIsBoundary(t *testing.T) {
+	iter := NewCharacterRBBI()
+	iter.SetCursor(&stuckCursor{StringCursor{text: "hello"}})
+
+	if iter.IsBoundary(2) {
+		t.Error("IsBoundary was true with a stuck Cursor")
+	}
+
+	expectError(t, iter, ErrCursor)
+}
+
+func TestErrorOneWayCursor(t *testing.T) {
+	iter := NewCharacterRBBI()
+	iter.SetCursor(&oneWayCursor{StringCursor: StringCursor{text: "hello"}})
+
+	if _, ok := iter.Preceding(3); ok {
+		t.Error("Preceding was ok with a Cursor that can't move back")
+	}
+
+	expectError(t, iter, ErrCursor)
+}
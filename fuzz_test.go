@@ -0,0 +1,194 @@
+package rbbi
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// The seed corpora of the fuzz targets are stored in testdata/fuzz, except
+// for the encoded tables used by FuzzDecodeRBBIData.
+
+// Check that the break iterators handle arbitrary bytes, including invalid
+// UTF-8, and that forward and backward iteration agree.
+func FuzzIterators(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		str := string(data)
+
+		for _, rules := range []*Rules{CharacterRules(), LineRules(), SentenceRules(), WordRules()} {
+			iter := rules.NewRBBI()
+			iter.SetCursor(NewStringCursor(str))
+
+			forward := []int{0}
+			for pos, ok := iter.Next(); ok; pos, ok = iter.Next() {
+				if pos <= forward[len(forward)-1] {
+					t.Fatalf("Next returned %v after %v for %q", pos, forward[len(forward)-1], str)
+				}
+
+				forward = append(forward, pos)
+			}
+
+			if iter.Err() != nil {
+				t.Fatalf("Unexpected error %v", iter.Err())
+			}
+
+			if forward[len(forward)-1] != len(str) {
+				t.Fatalf("Next did not end at the end of %q", str)
+			}
+
+			cursor := NewStringCursor(str)
+			cursor.SetPosition(len(str))
+			iter.SetCursor(cursor)
+
+			backward := []int{len(str)}
+			for pos, ok := iter.Previous(); ok; pos, ok = iter.Previous() {
+				if pos >= backward[0] {
+					t.Fatalf("Previous returned %v after %v for %q", pos, backward[0], str)
+				}
+
+				backward = append([]int{pos}, backward...)
+			}
+
+			if iter.Err() != nil {
+				t.Fatalf("Unexpected error %v", iter.Err())
+			}
+
+			if !reflect.DeepEqual(forward, backward) {
+				t.Fatalf("Forward breaks %v differ from backward breaks %v for %q", forward, backward, str)
+			}
+
+			if boundaries := iter.AppendBoundariesBytes(nil, data); !reflect.DeepEqual(boundaries, forward) {
+				t.Fatalf("AppendBoundariesBytes returned %v instead of %v for %q", boundaries, forward, str)
+			}
+		}
+	})
+}
+
+// Check that a RopeCursor yields the same runes and accepts the same positions
+// as a StringCursor, no matter how the text is split into nodes.
+func FuzzCursors(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte, split uint, position int) {
+		str := string(data)
+
+		rope := NewRope("")
+		if len(str) > 0 {
+			split %= uint(len(str))
+			rope.Insert(0, str[split:])
+			rope.Insert(0, str[:split])
+		}
+
+		if rope.String() != str {
+			t.Fatalf("Rope %q differs from %q", rope.String(), str)
+		}
+
+		for _, policy := range []AlignmentPolicy{AlignmentReject, AlignmentSnapBackward, AlignmentSnapForward} {
+			expected := NewStringCursor(str)
+			expected.SetAlignmentPolicy(policy)
+
+			cursor := rope.NewCursor()
+			cursor.SetAlignmentPolicy(policy)
+
+			expectedErr := expected.SetPosition(position)
+			if err := cursor.SetPosition(position); err != expectedErr || cursor.Position() != expected.Position() {
+				t.Fatalf("SetPosition(%v) moved to %v (%v), expected %v (%v)", position, cursor.Position(), err, expected.Position(), expectedErr)
+			}
+
+			for {
+				expectedRune, expectedOk := expected.Next()
+				r, ok := cursor.Next()
+
+				if r != expectedRune || ok != expectedOk || cursor.Position() != expected.Position() {
+					t.Fatalf("Next returned %q at %v, expected %q at %v", r, cursor.Position(), expectedRune, expected.Position())
+				}
+
+				if !ok {
+					break
+				}
+			}
+
+			for {
+				expectedRune, expectedOk := expected.Previous()
+				r, ok := cursor.Previous()
+
+				if r != expectedRune || ok != expectedOk || cursor.Position() != expected.Position() {
+					t.Fatalf("Previous returned %q at %v, expected %q at %v", r, cursor.Position(), expectedRune, expected.Position())
+				}
+
+				if !ok {
+					break
+				}
+			}
+		}
+	})
+}
+
+// Check that trie lookups handle any rune, including negative values and
+// values above U+10FFFF.
+func FuzzTrie(f *testing.F) {
+	tries := []*ucpTrie{
+		&decodedData(&characterRules).trie,
+		&decodedData(&lineRules).trie,
+		&decodedData(&sentenceRules).trie,
+		&decodedData(&wordRules).trie,
+		&widthTrie,
+	}
+
+	f.Fuzz(func(t *testing.T, c int32) {
+		for _, trie := range tries {
+			value, err := trie.get(c)
+			if err != nil {
+				t.Fatalf("Unexpected error %v for %#x", err, c)
+			}
+
+			errorValue, _ := trie.get(-1)
+			if (c < 0 || c > ucpTrieMaxUnicode) && value != errorValue {
+				t.Fatalf("Invalid value %#x for %#x, expected the error value %#x", value, c, errorValue)
+			}
+
+			if end, rangeValue, err := trie.getRange(c); c >= 0 && c <= ucpTrieMaxUnicode && (err != nil || end < c || rangeValue != value) {
+				t.Fatalf("Invalid range %#x..%#x with value %#x for %#x (%v)", c, end, rangeValue, c, err)
+			}
+		}
+	})
+}
+
+// Check that decoding arbitrary tables never panics, and that decoded tables
+// that pass validation can be used for iteration. The checksum is updated
+// before decoding, so that the fuzzer gets past it.
+func FuzzDecodeRBBIData(f *testing.F) {
+	for _, encoded := range []string{rbbiCharacterData, rbbiLineData, rbbiSentenceData, rbbiWordData} {
+		f.Add([]byte(encoded))
+	}
+
+	f.Fuzz(func(t *testing.T, encoded []byte) {
+		if len(encoded) >= rbbiDataHeaderLength {
+			updateChecksum(encoded)
+		}
+
+		data, err := decodeRBBIData(string(encoded))
+		if err != nil {
+			return
+		}
+
+		text := "Hello, world! 42 日本語"
+
+		iter := (&Rules{data: data}).NewRBBI()
+		iter.AppendBoundaries(nil, text)
+		if err := iter.Err(); err != nil && !errors.Is(err, ErrCorruptTable) {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		iter.SetCursor(NewStringCursor(text))
+		for {
+			if _, ok := iter.Next(); !ok {
+				break
+			}
+		}
+
+		for {
+			if _, ok := iter.Previous(); !ok {
+				break
+			}
+		}
+	})
+}
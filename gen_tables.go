@@ -0,0 +1,580 @@
+//go:build ignore
+// +build ignore
+
+// Generator for the encoded tables in data/*.rbbi. It reads the tables of
+// every kind of break from a text file in data/, builds the trie of the
+// character categories and the safe reverse state table, and writes the
+// encoding described in rbbi_data_encoding.go.
+//
+// Usage:
+//
+//	go run gen_tables.go [-out data]
+//
+// The text files consist of sections, each starting with an unindented line.
+// The indented lines that follow belong to the section, and everything after
+// a # is a comment:
+//
+//	default-category <n>
+//		The category of the code points that are not listed.
+//
+//	category <n> <name>
+//		The name of category n, followed by a line with a code point or an
+//		inclusive range of code points (U+0041..U+005A) for every range in
+//		the category. Categories 0, 1 and 2 are the pseudo categories for
+//		unused entries, the end of the text and the start of the text, and
+//		have no code points. Code points outside of U+0000..U+10FFFF have
+//		category 0.
+//
+//	forward-table
+//		The forward state table. It is followed by the lines
+//		"dict-categories-start <n>" and "lookahead-results <n>", the flags
+//		"lookahead-hard-break" and "bof-required" when they are set, and a
+//		line "state <n>: <accepting> <lookahead> <tag> | <next states>"
+//		for every state, with the next state for every category.
+//
+//	tags
+//		The rule status table, with a line "tag <n>: <statuses>" for every
+//		group of rule statuses, where n is the index of the group in the
+//		table.
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"hash/adler32"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/thedjinn/rbbi-go/internal/ucptrie"
+)
+
+// The kinds of breaks, which are the names of the text files and the
+// generated files.
+var kinds = []string{"character", "line", "sentence", "word"}
+
+// Encoding constants, these must be kept in sync with rbbi_data_encoding.go.
+const (
+	dataMagic   = "RBBI"
+	dataVersion = 1
+
+	dataHeaderLength = 10
+
+	flagLookaheadHardBreak = 0x1
+	flagBOFRequired        = 0x2
+
+	stateTableValueWidth8  = 0
+	stateTableValueWidth16 = 1
+)
+
+// The number of values preceding the next states in a row of a state table.
+const rowNextStates = 3
+
+// The start state of a state table. The stop state is 0.
+const stateStart = 1
+
+// A state table, with a row of values for every state.
+type stateTable struct {
+	dictCategoriesStart  int
+	lookaheadResultsSize int
+	lookaheadHardBreak   bool
+	bofRequired          bool
+
+	rows [][]int
+}
+
+// The tables of a kind of break, as read from a text file.
+type tables struct {
+	defaultCategory int
+	categoryNames   []string
+
+	// The code point ranges of every category
+	ranges [][][2]rune
+
+	forwardTable    stateTable
+	ruleStatusTable []int
+}
+
+// Parse an integer field.
+func parseInt(field string) (int, error) {
+	value, err := strconv.Atoi(field)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", field)
+	}
+
+	return value, nil
+}
+
+// Parse a code point written as U+0041.
+func parseCodePoint(field string) (rune, error) {
+	if !strings.HasPrefix(field, "U+") {
+		return 0, fmt.Errorf("invalid code point %q", field)
+	}
+
+	value, err := strconv.ParseUint(field[2:], 16, 32)
+	if err != nil || value > 0x10ffff {
+		return 0, fmt.Errorf("invalid code point %q", field)
+	}
+
+	return rune(value), nil
+}
+
+// Parse a line of the forward-table section.
+func (t *tables) parseStateTableLine(fields []string) error {
+	table := &t.forwardTable
+
+	switch fields[0] {
+	case "dict-categories-start":
+		if len(fields) != 2 {
+			return fmt.Errorf("expected a single value")
+		}
+
+		value, err := parseInt(fields[1])
+		table.dictCategoriesStart = value
+		return err
+	case "lookahead-results":
+		if len(fields) != 2 {
+			return fmt.Errorf("expected a single value")
+		}
+
+		value, err := parseInt(fields[1])
+		table.lookaheadResultsSize = value
+		return err
+	case "lookahead-hard-break":
+		table.lookaheadHardBreak = true
+		return nil
+	case "bof-required":
+		table.bofRequired = true
+		return nil
+	case "state":
+	default:
+		return fmt.Errorf("unknown field %q", fields[0])
+	}
+
+	if len(fields) != 2+rowNextStates+1+len(t.categoryNames) || fields[1] != fmt.Sprintf("%v:", len(table.rows)) || fields[2+rowNextStates] != "|" {
+		return fmt.Errorf("expected state %v with %v values and %v next states", len(table.rows), rowNextStates, len(t.categoryNames))
+	}
+
+	row := []int{}
+	for i, field := range fields[2:] {
+		if i == rowNextStates {
+			continue
+		}
+
+		value, err := parseInt(field)
+		if err != nil {
+			return err
+		}
+
+		row = append(row, value)
+	}
+
+	table.rows = append(table.rows, row)
+	return nil
+}
+
+// Parse a line of the tags section.
+func (t *tables) parseTagLine(fields []string) error {
+	if len(fields) < 3 || fields[0] != "tag" || fields[1] != fmt.Sprintf("%v:", len(t.ruleStatusTable)) {
+		return fmt.Errorf("expected tag %v with at least one rule status", len(t.ruleStatusTable))
+	}
+
+	t.ruleStatusTable = append(t.ruleStatusTable, len(fields)-2)
+	for _, field := range fields[2:] {
+		value, err := parseInt(field)
+		if err != nil {
+			return err
+		}
+
+		t.ruleStatusTable = append(t.ruleStatusTable, value)
+	}
+
+	return nil
+}
+
+// Parse an indented line of a section.
+func (t *tables) parseLine(section string, fields []string) error {
+	switch section {
+	case "category":
+		if len(fields) != 1 {
+			return fmt.Errorf("expected a code point range")
+		}
+
+		bounds := strings.SplitN(fields[0], "..", 2)
+		start, err := parseCodePoint(bounds[0])
+		if err != nil {
+			return err
+		}
+
+		end := start
+		if len(bounds) == 2 {
+			if end, err = parseCodePoint(bounds[1]); err != nil {
+				return err
+			}
+		}
+
+		category := len(t.categoryNames) - 1
+		if category <= 2 || category == t.defaultCategory || end < start {
+			return fmt.Errorf("invalid code point range %v for category %v", fields[0], category)
+		}
+
+		t.ranges[category] = append(t.ranges[category], [2]rune{start, end})
+		return nil
+	case "forward-table":
+		return t.parseStateTableLine(fields)
+	case "tags":
+		return t.parseTagLine(fields)
+	default:
+		return fmt.Errorf("unexpected indented line")
+	}
+}
+
+// Parse the unindented line that starts a section.
+func (t *tables) parseSection(fields []string) error {
+	switch fields[0] {
+	case "default-category":
+		if len(fields) != 2 {
+			return fmt.Errorf("expected a single category")
+		}
+
+		value, err := parseInt(fields[1])
+		t.defaultCategory = value
+		return err
+	case "category":
+		if len(fields) != 3 || fields[1] != strconv.Itoa(len(t.categoryNames)) {
+			return fmt.Errorf("expected category %v and its name", len(t.categoryNames))
+		}
+
+		t.categoryNames = append(t.categoryNames, fields[2])
+		t.ranges = append(t.ranges, nil)
+		return nil
+	case "forward-table", "tags":
+		if len(fields) != 1 {
+			return fmt.Errorf("unexpected values after %v", fields[0])
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown section %q", fields[0])
+	}
+}
+
+// Read the tables of a kind of break from a text file.
+func readTables(path string) (*tables, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	t := &tables{}
+	section := ""
+
+	scanner := bufio.NewScanner(file)
+	for number := 1; scanner.Scan(); number++ {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if line[0] == ' ' || line[0] == '\t' {
+			err = t.parseLine(section, fields)
+		} else {
+			section = fields[0]
+			err = t.parseSection(fields)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%v:%v: %v", path, number, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := t.check(); err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+
+	return t, nil
+}
+
+// Check the consistency of the tables, so that mistakes in a text file are
+// reported here rather than as a corrupt table at runtime.
+func (t *tables) check() error {
+	categoryCount := len(t.categoryNames)
+	if categoryCount <= 3 || t.defaultCategory <= 2 || t.defaultCategory >= categoryCount {
+		return fmt.Errorf("invalid default category %v of %v categories", t.defaultCategory, categoryCount)
+	}
+
+	table := &t.forwardTable
+	if len(table.rows) <= stateStart {
+		return fmt.Errorf("the forward table has no start state")
+	}
+
+	if table.dictCategoriesStart > categoryCount {
+		return fmt.Errorf("dict-categories-start %v exceeds the %v categories", table.dictCategoriesStart, categoryCount)
+	}
+
+	tags := map[int]bool{}
+	for i := 0; i < len(t.ruleStatusTable); i += t.ruleStatusTable[i] + 1 {
+		tags[i] = true
+	}
+
+	for state, row := range table.rows {
+		// Accepting values above 1 and non-zero lookahead values refer to
+		// lookahead results
+		if accepting := row[0]; accepting < 0 || accepting > 1 && accepting >= table.lookaheadResultsSize {
+			return fmt.Errorf("accepting value %v of state %v is out of range", accepting, state)
+		}
+
+		if lookahead := row[1]; lookahead < 0 || lookahead != 0 && (lookahead == 1 || lookahead >= table.lookaheadResultsSize) {
+			return fmt.Errorf("lookahead value %v of state %v is out of range", lookahead, state)
+		}
+
+		if !tags[row[2]] {
+			return fmt.Errorf("state %v refers to tag %v, which is not the start of a tag", state, row[2])
+		}
+
+		for _, next := range row[rowNextStates:] {
+			if next < 0 || next >= len(table.rows) {
+				return fmt.Errorf("state %v has a transition to unknown state %v", state, next)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Build the safe reverse table for a forward table, like ICU's
+// RBBITableBuilder::buildSafeReverseTable(). A pair of categories is safe
+// when the forward table reaches the same state after reading them, no matter
+// which state it started in. The reverse table stops at the first safe pair,
+// which is a position from which forward iteration gives the same breaks as
+// iteration from the start of the text.
+func buildSafeReverseTable(forward *stateTable, categoryCount int) stateTable {
+	next := func(state, category int) int {
+		return forward.rows[state][rowNextStates+category]
+	}
+
+	// Row 0 is the stop state, row 1 the start state, and every other row
+	// is the state after reading the category of the row minus 2
+	rows := make([][]int, categoryCount+2)
+	for row := range rows {
+		rows[row] = make([]int, categoryCount)
+
+		if row > 0 {
+			for category := range rows[row] {
+				rows[row][category] = category + 2
+			}
+		}
+	}
+
+	for c1 := 0; c1 < categoryCount; c1++ {
+		for c2 := 0; c2 < categoryCount; c2++ {
+			safe := true
+			wanted := next(next(stateStart, c1), c2)
+
+			for state := stateStart + 1; state < len(forward.rows); state++ {
+				if next(next(state, c1), c2) != wanted {
+					safe = false
+					break
+				}
+			}
+
+			if safe {
+				rows[c2+2][c1] = 0
+			}
+		}
+	}
+
+	// Merge duplicate rows, including rows that only differ in transitions
+	// to each other
+	for first := 1; first < len(rows)-1; first++ {
+		for second := first + 1; second < len(rows); second++ {
+			match := true
+			for category, value := range rows[first] {
+				duplicate := rows[second][category]
+				if value != duplicate && !((value == first || value == second) && (duplicate == first || duplicate == second)) {
+					match = false
+					break
+				}
+			}
+
+			if !match {
+				continue
+			}
+
+			rows = append(rows[:second], rows[second+1:]...)
+			for _, row := range rows {
+				for category, value := range row {
+					if value == second {
+						row[category] = first
+					} else if value > second {
+						row[category] = value - 1
+					}
+				}
+			}
+
+			second = first
+		}
+	}
+
+	// The reverse table doesn't accept, look ahead or have rule statuses
+	reverse := stateTable{}
+	for _, row := range rows {
+		reverse.rows = append(reverse.rows, append([]int{0, 0, 0}, row...))
+	}
+
+	return reverse
+}
+
+// A little endian encoder for the format described in rbbi_data_encoding.go.
+type encoder struct {
+	bytes []byte
+}
+
+func (e *encoder) uint8(value uint8) {
+	e.bytes = append(e.bytes, value)
+}
+
+func (e *encoder) uint16(value uint16) {
+	e.bytes = append(e.bytes, byte(value), byte(value>>8))
+}
+
+func (e *encoder) uint32(value uint32) {
+	e.bytes = append(e.bytes, byte(value), byte(value>>8), byte(value>>16), byte(value>>24))
+}
+
+// Encode a state table, using 8-bit values when they all fit.
+func (e *encoder) stateTable(table *stateTable) {
+	e.uint32(uint32(len(table.rows)))
+	e.uint32(uint32(len(table.rows[0])))
+	e.uint32(uint32(table.dictCategoriesStart))
+	e.uint32(uint32(table.lookaheadResultsSize))
+
+	var flags uint8
+	if table.lookaheadHardBreak {
+		flags |= flagLookaheadHardBreak
+	}
+
+	if table.bofRequired {
+		flags |= flagBOFRequired
+	}
+
+	e.uint8(flags)
+
+	var values []int
+	wide := false
+	for _, row := range table.rows {
+		for _, value := range row {
+			values = append(values, value)
+			wide = wide || value > 0xff
+		}
+	}
+
+	if wide {
+		e.uint8(stateTableValueWidth16)
+		e.uint32(uint32(len(values)))
+		for _, value := range values {
+			e.uint16(uint16(value))
+		}
+	} else {
+		e.uint8(stateTableValueWidth8)
+		e.uint32(uint32(len(values)))
+		for _, value := range values {
+			e.uint8(uint8(value))
+		}
+	}
+}
+
+// Encode the tables of a kind of break.
+func (t *tables) encode() ([]byte, error) {
+	builder := ucptrie.NewBuilder(uint32(t.defaultCategory), 0)
+	for category, ranges := range t.ranges {
+		for _, r := range ranges {
+			builder.SetRange(r[0], r[1], uint32(category))
+		}
+	}
+
+	trie, err := builder.Build(ucptrie.TypeFast, ucptrie.ValueWidth8)
+	if err != nil {
+		return nil, err
+	}
+
+	reverseTable := buildSafeReverseTable(&t.forwardTable, len(t.categoryNames))
+
+	e := encoder{}
+	e.bytes = append(e.bytes, dataMagic...)
+	e.uint16(dataVersion)
+	e.uint32(0) // Checksum
+
+	e.uint32(uint32(len(t.categoryNames)))
+	e.uint32(uint32(len(t.categoryNames)))
+	for _, name := range t.categoryNames {
+		e.uint8(uint8(len(name)))
+		e.bytes = append(e.bytes, name...)
+	}
+
+	e.stateTable(&t.forwardTable)
+	e.stateTable(&reverseTable)
+
+	e.uint32(uint32(len(t.ruleStatusTable)))
+	for _, value := range t.ruleStatusTable {
+		e.uint32(uint32(value))
+	}
+
+	e.uint8(uint8(trie.Type))
+	e.uint8(uint8(trie.ValueWidth))
+	e.uint32(uint32(trie.DataLength))
+	e.uint16(trie.Index3NullOffset)
+	e.uint16(trie.DataNullOffset)
+	e.uint32(uint32(trie.HighStart))
+	e.uint32(trie.Shifted12HighStart)
+	e.uint32(trie.NullValueOffset)
+	e.uint32(trie.NullValue)
+
+	e.uint32(uint32(len(trie.Index)))
+	for _, value := range trie.Index {
+		e.uint16(value)
+	}
+
+	e.uint32(uint32(len(trie.Data8)))
+	e.bytes = append(e.bytes, trie.Data8...)
+
+	binary.LittleEndian.PutUint32(e.bytes[6:], adler32.Checksum(e.bytes[dataHeaderLength:]))
+	return e.bytes, nil
+}
+
+func main() {
+	out := flag.String("out", "data", "directory to write the encoded tables to")
+	flag.Parse()
+
+	for _, kind := range kinds {
+		t, err := readTables(filepath.Join("data", kind+".txt"))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		encoded, err := t.encode()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v: %v\n", kind, err)
+			os.Exit(1)
+		}
+
+		if err := os.WriteFile(filepath.Join(*out, kind+".rbbi"), encoded, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+//go:build ignore
+// +build ignore
+
+// Generator for width_data.go. It reads the EastAsianWidth.txt,
+// extracted/DerivedGeneralCategory.txt, emoji-data.txt and
+// emoji-variation-sequences.txt files from the Unicode Character Database and
+// writes a trie with the display width properties of every code point.
+//
+// Usage:
+//
+//	go run gen_width.go -ucd /path/to/ucd > width_data.go
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/thedjinn/rbbi-go/internal/ucptrie"
+)
+
+// Property values, these must be kept in sync with width.go.
+const (
+	widthZero      = 0
+	widthNarrow    = 1
+	widthWide      = 2
+	widthAmbiguous = 3
+
+	widthEmojiPresentation = 0x4
+	widthVariationBase     = 0x8
+)
+
+// Parse a UCD data file and invoke fn for every code point range. The fields
+// of the line (excluding the code point field) are passed in as well.
+func parseFile(path string, fn func(start, end rune, fields []string)) {
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		// Variation sequences list two code points, only the first one is
+		// of interest.
+		codePoints := strings.Fields(fields[0])[0]
+
+		bounds := strings.SplitN(codePoints, "..", 2)
+		start, err := strconv.ParseUint(bounds[0], 16, 32)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.ParseUint(bounds[1], 16, 32)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}
+
+		fn(rune(start), rune(end), fields[1:])
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// Print a slice of values in rows of 16 entries.
+func printValues(name, typ string, count int, value func(int) uint32) {
+	fmt.Printf("\t%s: []%s{\n", name, typ)
+
+	for i := 0; i < count; i += 16 {
+		values := []string{}
+		for j := i; j < i+16 && j < count; j++ {
+			values = append(values, strconv.FormatUint(uint64(value(j)), 10))
+		}
+
+		fmt.Printf("\t\t%s,\n", strings.Join(values, ", "))
+	}
+
+	fmt.Printf("\t},\n")
+}
+
+func main() {
+	ucd := flag.String("ucd", ".", "directory containing the UCD files")
+	flag.Parse()
+
+	builder := ucptrie.NewBuilder(widthNarrow, widthNarrow)
+
+	// Unassigned code points in the CJK blocks default to wide.
+	for _, r := range [][2]rune{
+		{0x3400, 0x4dbf},
+		{0x4e00, 0x9fff},
+		{0xf900, 0xfaff},
+		{0x20000, 0x2fffd},
+		{0x30000, 0x3fffd},
+	} {
+		builder.SetRange(r[0], r[1], widthWide)
+	}
+
+	parseFile(filepath.Join(*ucd, "EastAsianWidth.txt"), func(start, end rune, fields []string) {
+		switch fields[0] {
+		case "W", "F":
+			builder.SetRange(start, end, widthWide)
+		case "A":
+			builder.SetRange(start, end, widthAmbiguous)
+		default:
+			builder.SetRange(start, end, widthNarrow)
+		}
+	})
+
+	// Nonspacing marks, enclosing marks, format and control characters, and
+	// the medial vowels and final consonants of conjoining Hangul jamo do not
+	// occupy a cell of their own. The general categories are read from the
+	// same version of the UCD as the other properties.
+	parseFile(filepath.Join(*ucd, "extracted", "DerivedGeneralCategory.txt"), func(start, end rune, fields []string) {
+		switch fields[0] {
+		case "Mn", "Me", "Cf", "Cc":
+			// The soft hyphen is displayed when a line is broken at it
+			for c := start; c <= end; c++ {
+				if c != 0xad {
+					builder.Set(c, widthZero)
+				}
+			}
+		}
+	})
+	builder.SetRange(0x1160, 0x11ff, widthZero)
+	builder.SetRange(0xd7b0, 0xd7ff, widthZero)
+
+	parseFile(filepath.Join(*ucd, "emoji-data.txt"), func(start, end rune, fields []string) {
+		if fields[0] == "Emoji_Presentation" {
+			builder.SetRange(start, end, widthWide|widthEmojiPresentation)
+		}
+	})
+
+	parseFile(filepath.Join(*ucd, "emoji-variation-sequences.txt"), func(start, end rune, fields []string) {
+		for c := start; c <= end; c++ {
+			builder.Set(c, builder.Get(c)|widthVariationBase)
+		}
+	})
+
+	trie, err := builder.Build(ucptrie.TypeFast, ucptrie.ValueWidth8)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("// This file is auto-generated by gen_width.go\n\n")
+	fmt.Printf("package rbbi\n\n")
+	fmt.Printf("// Lookup table for the display width properties of code points.\n")
+	fmt.Printf("var widthTrie ucpTrie = ucpTrie{\n")
+	fmt.Printf("\ttrieType:           ucpTrieTypeFast,\n")
+	fmt.Printf("\tvalueWidth:         ucpTrieValueWidth8,\n")
+	fmt.Printf("\tdataLength:         %d,\n", trie.DataLength)
+	fmt.Printf("\tindex3NullOffset:   %d,\n", trie.Index3NullOffset)
+	fmt.Printf("\tdataNullOffset:     %d,\n", trie.DataNullOffset)
+	fmt.Printf("\thighStart:          %d,\n", trie.HighStart)
+	fmt.Printf("\tshifted12HighStart: %d,\n", trie.Shifted12HighStart)
+	fmt.Printf("\tnullValueOffset:    %d,\n\n", trie.NullValueOffset)
+
+	printValues("index", "uint16", len(trie.Index), func(i int) uint32 {
+		return uint32(trie.Index[i])
+	})
+	fmt.Println()
+	printValues("data8", "uint8", len(trie.Data8), func(i int) uint32 {
+		return uint32(trie.Data8[i])
+	})
+	fmt.Println()
+
+	fmt.Printf("\tnullValue: %d,\n", trie.NullValue)
+	fmt.Printf("}\n")
+}
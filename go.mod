@@ -1,3 +1,3 @@
 module github.com/thedjinn/rbbi-go
 
-go 1.17
+go 1.18
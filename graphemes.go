@@ -0,0 +1,143 @@
+package rbbi
+
+import "strings"
+
+// Return the number of grapheme clusters (user-perceived characters) in a
+// string.
+func GraphemeCount(text string) int {
+	iter := NewCharacterRBBI()
+	iter.SetCursor(NewStringCursor(text))
+
+	count := 0
+	for {
+		if _, ok := iter.Next(); !ok {
+			return count
+		}
+
+		count++
+	}
+}
+
+// Split a string into its grapheme clusters.
+func Graphemes(text string) []string {
+	iter := NewCharacterRBBI()
+	iter.SetCursor(NewStringCursor(text))
+
+	graphemes := []string{}
+	start := 0
+	for {
+		end, ok := iter.Next()
+		if !ok {
+			return graphemes
+		}
+
+		graphemes = append(graphemes, text[start:end])
+		start = end
+	}
+}
+
+// Return a string with its grapheme clusters in reverse order. Unlike
+// reversing the runes of a string, combining marks stay attached to their base
+// characters and emoji sequences are kept intact.
+func ReverseGraphemes(text string) string {
+	iter := NewCharacterRBBI()
+	cursor := NewStringCursor(text)
+	cursor.SetPosition(len(text))
+	iter.SetCursor(cursor)
+
+	var builder strings.Builder
+	builder.Grow(len(text))
+
+	end := len(text)
+	for {
+		start, ok := iter.Previous()
+		if !ok {
+			return builder.String()
+		}
+
+		builder.WriteString(text[start:end])
+		end = start
+	}
+}
+
+// Return the grapheme cluster at the provided grapheme index. The value of ok
+// is false when the index is out of range.
+func GraphemeAt(text string, index int) (grapheme string, ok bool) {
+	start, ok := GraphemeOffset(text, index)
+	if !ok || start == len(text) {
+		return "", false
+	}
+
+	end, _ := GraphemeOffset(text[start:], 1)
+	return text[start : start+end], true
+}
+
+// Return the substring containing the grapheme clusters with an index in the
+// range [from, to). The indexes are clamped to the number of grapheme clusters
+// in the string, and an empty string is returned when from is not less than
+// to.
+func SliceGraphemes(text string, from, to int) string {
+	if from < 0 {
+		from = 0
+	}
+
+	if to <= from {
+		return ""
+	}
+
+	start, ok := GraphemeOffset(text, from)
+	if !ok {
+		return ""
+	}
+
+	end, ok := GraphemeOffset(text[start:], to-from)
+	if !ok {
+		return text[start:]
+	}
+
+	return text[start : start+end]
+}
+
+// Convert a grapheme index to a byte offset. The returned offset is the start
+// of the grapheme cluster with the provided index. An index equal to the number
+// of grapheme clusters yields the length of the string. The value of ok is
+// false when the index is out of range.
+func GraphemeOffset(text string, index int) (offset int, ok bool) {
+	if index < 0 {
+		return -1, false
+	}
+
+	iter := NewCharacterRBBI()
+	iter.SetCursor(NewStringCursor(text))
+
+	for i := 0; i < index; i++ {
+		offset, ok = iter.Next()
+		if !ok {
+			return -1, false
+		}
+	}
+
+	return offset, true
+}
+
+// Convert a byte offset to a grapheme index. The returned index is the index
+// of the grapheme cluster containing the byte at the provided offset. An offset
+// equal to the length of the string yields the number of grapheme clusters.
+// The value of ok is false when the offset is out of range.
+func GraphemeIndex(text string, offset int) (index int, ok bool) {
+	if offset < 0 || offset > len(text) {
+		return -1, false
+	}
+
+	iter := NewCharacterRBBI()
+	iter.SetCursor(NewStringCursor(text))
+
+	for {
+		position, ok := iter.Next()
+		if !ok || position > offset {
+			return index, true
+		}
+
+		index++
+	}
+}
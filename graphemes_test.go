@@ -0,0 +1,121 @@
+package rbbi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGraphemeCount(t *testing.T) {
+	if count := GraphemeCount(""); count != 0 {
+		t.Errorf("Invalid grapheme count %v for empty string", count)
+	}
+
+	if count := GraphemeCount("🐨🏴‍☠️❤️‍🔥🥕"); count != 4 {
+		t.Errorf("Invalid grapheme count %v for emoji", count)
+	}
+
+	if count := GraphemeCount("h̷̝͈͉̎̇̋̓̄e̴̻̊̂̏̑̏l̸̢͚̬͇̗͂̿͠l̴̢̨̼͇̍̓͌͋o̷̫͋"); count != 5 {
+		t.Errorf("Invalid grapheme count %v for zalgo", count)
+	}
+}
+
+func TestGraphemes(t *testing.T) {
+	graphemes := Graphemes("a🏴‍☠️é")
+	expected := []string{"a", "🏴‍☠️", "é"}
+
+	if !reflect.DeepEqual(graphemes, expected) {
+		t.Errorf("Invalid graphemes %q", graphemes)
+	}
+}
+
+func TestReverseGraphemes(t *testing.T) {
+	if reversed := ReverseGraphemes(""); reversed != "" {
+		t.Errorf("Invalid reversal %q of empty string", reversed)
+	}
+
+	if reversed := ReverseGraphemes("hello"); reversed != "olleh" {
+		t.Errorf("Invalid reversal %q of ascii", reversed)
+	}
+
+	if reversed := ReverseGraphemes("🐨🏴‍☠️❤️‍🔥🥕"); reversed != "🥕❤️‍🔥🏴‍☠️🐨" {
+		t.Errorf("Invalid reversal %q of emoji", reversed)
+	}
+
+	if reversed := ReverseGraphemes("éa"); reversed != "aé" {
+		t.Errorf("Invalid reversal %q of combining mark", reversed)
+	}
+}
+
+func TestGraphemeAt(t *testing.T) {
+	str := "🐨🏴‍☠️❤️‍🔥🥕"
+
+	if grapheme, ok := GraphemeAt(str, 1); !ok || grapheme != "🏴‍☠️" {
+		t.Errorf("Invalid grapheme %q at index 1", grapheme)
+	}
+
+	if grapheme, ok := GraphemeAt(str, 3); !ok || grapheme != "🥕" {
+		t.Errorf("Invalid grapheme %q at index 3", grapheme)
+	}
+
+	if _, ok := GraphemeAt(str, 4); ok {
+		t.Error("GraphemeAt was ok beyond end of string")
+	}
+
+	if _, ok := GraphemeAt(str, -1); ok {
+		t.Error("GraphemeAt was ok before start of string")
+	}
+}
+
+func TestSliceGraphemes(t *testing.T) {
+	str := "🐨🏴‍☠️❤️‍🔥🥕"
+
+	if slice := SliceGraphemes(str, 1, 3); slice != "🏴‍☠️❤️‍🔥" {
+		t.Errorf("Invalid slice %q", slice)
+	}
+
+	if slice := SliceGraphemes(str, -5, 1); slice != "🐨" {
+		t.Errorf("Invalid slice %q with negative start", slice)
+	}
+
+	if slice := SliceGraphemes(str, 2, 100); slice != "❤️‍🔥🥕" {
+		t.Errorf("Invalid slice %q with end beyond string", slice)
+	}
+
+	if slice := SliceGraphemes(str, 3, 1); slice != "" {
+		t.Errorf("Invalid slice %q with reversed range", slice)
+	}
+
+	if slice := SliceGraphemes(str, 10, 12); slice != "" {
+		t.Errorf("Invalid slice %q beyond string", slice)
+	}
+}
+
+func TestGraphemeOffset(t *testing.T) {
+	str := "🐨🏴‍☠️❤️‍🔥🥕"
+	offsets := []int{0, 4, 17, 30, 34}
+
+	for index, expected := range offsets {
+		if offset, ok := GraphemeOffset(str, index); !ok || offset != expected {
+			t.Errorf("Invalid offset %v for index %v", offset, index)
+		}
+	}
+
+	if _, ok := GraphemeOffset(str, 5); ok {
+		t.Error("GraphemeOffset was ok beyond end of string")
+	}
+}
+
+func TestGraphemeIndex(t *testing.T) {
+	str := "🐨🏴‍☠️❤️‍🔥🥕"
+	indexes := map[int]int{0: 0, 3: 0, 4: 1, 16: 1, 17: 2, 33: 3, 34: 4}
+
+	for offset, expected := range indexes {
+		if index, ok := GraphemeIndex(str, offset); !ok || index != expected {
+			t.Errorf("Invalid index %v for offset %v", index, offset)
+		}
+	}
+
+	if _, ok := GraphemeIndex(str, 35); ok {
+		t.Error("GraphemeIndex was ok beyond end of string")
+	}
+}
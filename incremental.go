@@ -0,0 +1,195 @@
+package rbbi
+
+import (
+	"sort"
+)
+
+// The IncrementalSegmenter maintains the sorted list of breaks of a text that
+// is being edited. After an edit only the text surrounding the edit is
+// rescanned, which makes it suitable for keeping the breaks of a document up
+// to date while typing.
+//
+// Unlike the break iterator itself, the IncrementalSegmenter requires the
+// positions of the Cursor to be offsets relative to the start of the text
+// (e.g. byte offsets, as used by StringCursor), so that breaks following an
+// edit can be shifted by the change in length.
+type IncrementalSegmenter struct {
+	iter *RBBI
+
+	// Sorted list of breaks, including the start and end of the text
+	boundaries []int
+}
+
+// The BoundaryChange describes the effect of an edit on the list of breaks.
+type BoundaryChange struct {
+	// The range of the new text that was rescanned
+	Start int
+	End   int
+
+	// Positions of breaks in the old text that no longer exist. Breaks that
+	// only moved because of a change in length before them are not reported.
+	Removed []int
+
+	// Positions of breaks in the new text that did not exist before.
+	Added []int
+}
+
+// Instantiate a new IncrementalSegmenter using the provided break iterator.
+// The Cursor is assigned to the break iterator and the entire text is scanned
+// for breaks, starting at position 0.
+//
+// When the Cursor refuses position 0 or the break iterator fails, scanning
+// stops and the error can be retrieved using Err() of the iterator.
+func NewIncrementalSegmenter(iter *RBBI, cursor Cursor) *IncrementalSegmenter {
+	s := &IncrementalSegmenter{
+		iter: iter,
+	}
+
+	iter.SetCursor(cursor)
+	if err := iter.setPosition(0); err != nil {
+		// Scanning stops right away, leaving the error in the iterator
+		iter.err = err
+	}
+
+	s.boundaries = []int{0}
+	for {
+		breakpoint, ok := iter.Next()
+		if !ok {
+			break
+		}
+
+		s.boundaries = append(s.boundaries, breakpoint)
+	}
+
+	return s
+}
+
+// Return the sorted list of breaks, including the start and end of the text.
+// The returned slice must not be modified.
+func (s *IncrementalSegmenter) Boundaries() []int {
+	return s.boundaries
+}
+
+// Update the list of breaks after an edit. The Cursor must iterate over the
+// edited text, and may be the same Cursor as before if it reflects the edit.
+// The edit replaced deleted bytes at the provided offset with inserted bytes.
+//
+// Rescanning starts at the last known break before a safe point preceding the
+// edit, and stops as soon as a break is found that matches one of the old
+// breaks following the edit. From that point on the old breaks are identical,
+// apart from being shifted by the change in length.
+//
+// When the Cursor refuses the restart position or the break iterator fails,
+// the breaks following the restart position are dropped and the error can be
+// retrieved using Err() of the iterator.
+func (s *IncrementalSegmenter) Edit(cursor Cursor, offset, deleted, inserted int) BoundaryChange {
+	s.iter.SetCursor(cursor)
+
+	old := s.boundaries
+	delta := inserted - deleted
+
+	// Return whether an old break follows the edit, meaning that it moves
+	// along with the change in length. A break at the offset of an insertion
+	// is considered to precede it.
+	shifted := func(oldBreakpoint int) bool {
+		return oldBreakpoint > offset && oldBreakpoint >= offset+deleted
+	}
+
+	// The text before the edit is unchanged, so the safe reverse rules can be
+	// used to find a point from which forward iteration gives correct
+	// results.
+	safe, ok, err := s.iter.safePrevious(offset)
+	if err != nil {
+		// Rescanning stops right away, leaving the error in the iterator
+		s.iter.err = err
+	}
+
+	if !ok {
+		safe = 0
+	}
+
+	// Restart from the last old break at or before the safe point
+	restartIndex := sort.SearchInts(old, safe)
+	if restartIndex == len(old) || old[restartIndex] > safe {
+		restartIndex--
+	}
+
+	restart := old[restartIndex]
+	boundaries := append([]int{}, old[:restartIndex+1]...)
+
+	// Scan forward until we are in sync with the old breaks again
+	syncIndex := len(old)
+
+	if err := s.iter.setPosition(restart); err != nil && s.iter.err == nil {
+		s.iter.err = err
+	}
+
+	for {
+		breakpoint, ok := s.iter.Next()
+		if !ok {
+			break
+		}
+
+		boundaries = append(boundaries, breakpoint)
+
+		if breakpoint >= offset+inserted {
+			oldBreakpoint := breakpoint - delta
+			i := sort.SearchInts(old, oldBreakpoint)
+
+			if i < len(old) && old[i] == oldBreakpoint && shifted(oldBreakpoint) {
+				syncIndex = i + 1
+				break
+			}
+		}
+	}
+
+	change := BoundaryChange{
+		Start:   restart,
+		End:     boundaries[len(boundaries)-1],
+		Removed: []int{},
+		Added:   []int{},
+	}
+
+	// Compare the rescanned breaks with the old breaks in the same range,
+	// after translating the old breaks to positions in the new text.
+	rescanned := boundaries[restartIndex+1:]
+	translated := map[int]bool{}
+
+	for _, oldBreakpoint := range old[restartIndex+1 : syncIndex] {
+		position := oldBreakpoint
+
+		if shifted(oldBreakpoint) {
+			position += delta
+		} else if oldBreakpoint > offset {
+			// The break was inside the deleted text
+			change.Removed = append(change.Removed, oldBreakpoint)
+			continue
+		}
+
+		translated[position] = true
+		if !containsInt(rescanned, position) {
+			change.Removed = append(change.Removed, oldBreakpoint)
+		}
+	}
+
+	for _, breakpoint := range rescanned {
+		if !translated[breakpoint] {
+			change.Added = append(change.Added, breakpoint)
+		}
+	}
+
+	// The remaining old breaks are only shifted
+	for _, oldBreakpoint := range old[syncIndex:] {
+		boundaries = append(boundaries, oldBreakpoint+delta)
+	}
+
+	s.boundaries = boundaries
+
+	return change
+}
+
+// Return whether a sorted slice contains a value.
+func containsInt(values []int, value int) bool {
+	i := sort.SearchInts(values, value)
+	return i < len(values) && values[i] == value
+}
@@ -0,0 +1,145 @@
+package rbbi
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func allBoundaries(iter *RBBI, str string) []int {
+	iter.SetCursor(NewStringCursor(str))
+
+	boundaries := []int{0}
+	for {
+		pos, ok := iter.Next()
+		if !ok {
+			return boundaries
+		}
+
+		boundaries = append(boundaries, pos)
+	}
+}
+
+func TestIncrementalSegmenterEdit(t *testing.T) {
+	str := "Hello world. This is a test."
+	segmenter := NewIncrementalSegmenter(NewWordRBBI(), NewStringCursor(str))
+
+	// Replace "world" with "there, friend"
+	str = str[:6] + "there, friend" + str[11:]
+	change := segmenter.Edit(NewStringCursor(str), 6, 5, 13)
+
+	if !reflect.DeepEqual(segmenter.Boundaries(), allBoundaries(NewWordRBBI(), str)) {
+		t.Errorf("Invalid boundaries %v after edit", segmenter.Boundaries())
+	}
+
+	if !reflect.DeepEqual(change.Removed, []int{}) {
+		t.Errorf("Invalid removed boundaries %v", change.Removed)
+	}
+
+	if !reflect.DeepEqual(change.Added, []int{11, 12, 13}) {
+		t.Errorf("Invalid added boundaries %v", change.Added)
+	}
+
+	if change.End >= len(str) {
+		t.Errorf("Rescanned until %v, beyond the edit", change.End)
+	}
+}
+
+func TestIncrementalSegmenterRandomEdits(t *testing.T) {
+	// Numbers, quotes, abbreviations and emoji sequences make the rules look
+	// ahead, so that breaks after an edit depend on the text before it
+	fragments := []string{
+		"a", "b", " ", ".", ",", "\n", "é", "\u0301", "🏴", "\u200d", "☠️", "42", "3.14", "1,000", "$10", "10%",
+		"日本", "Mr. ", "e.g. ", "can't", "'", "\"", "(a)", "a-b", "—", "\r\n", "👩\u200d👩\u200d👧", "🇳🇱", "🇳",
+	}
+
+	constructors := []func() *RBBI{NewCharacterRBBI, NewWordRBBI, NewLineRBBI, NewSentenceRBBI}
+
+	for seed := int64(1); seed <= 5; seed++ {
+		random := rand.New(rand.NewSource(seed))
+
+		for _, constructor := range constructors {
+			str := "The quick brown fox. Jumps over 3.14 lazy dogs!\n\"Can't\" cost $1,000.50 (e.g. 10%) 👩\u200d👩\u200d👧🇳🇱 end."
+			segmenter := NewIncrementalSegmenter(constructor(), NewStringCursor(str))
+
+			restarts := 0
+			for i := 0; i < 500; i++ {
+				// Pick a random rune-aligned edit range
+				runes := []rune(str)
+				from := random.Intn(len(runes) + 1)
+				to := from + random.Intn(len(runes)-from+1)
+				if to-from > 3 {
+					to = from + 3
+				}
+
+				offset := len(string(runes[:from]))
+				deleted := len(string(runes[from:to]))
+				insertion := ""
+				for j := random.Intn(4); j > 0; j-- {
+					insertion += fragments[random.Intn(len(fragments))]
+				}
+
+				str = str[:offset] + insertion + str[offset+deleted:]
+				change := segmenter.Edit(NewStringCursor(str), offset, deleted, len(insertion))
+
+				expected := allBoundaries(constructor(), str)
+				if !reflect.DeepEqual(segmenter.Boundaries(), expected) {
+					t.Fatalf("Invalid boundaries %v after edit of %q with seed %v, expected %v", segmenter.Boundaries(), str, seed, expected)
+				}
+
+				if change.Start > 0 {
+					restarts++
+				}
+			}
+
+			// Most edits should restart from a safe point rather than from
+			// the start of the text
+			if restarts < 250 {
+				t.Errorf("Only %v of the edits with seed %v restarted after the start of the text", restarts, seed)
+			}
+		}
+	}
+}
+
+// A Cursor that refuses a single position.
+type refusingPositionCursor struct {
+	StringCursor
+	refused int
+}
+
+func (c *refusingPositionCursor) SetPosition(position int) error {
+	if position == c.refused {
+		return errors.New("Refused")
+	}
+
+	return c.StringCursor.SetPosition(position)
+}
+
+func TestIncrementalSegmenterRefusingCursor(t *testing.T) {
+	str := "Hello world. This is a test."
+
+	iter := NewWordRBBI()
+	segmenter := NewIncrementalSegmenter(iter, &refusingPositionCursor{StringCursor{text: str}, 0})
+
+	if !reflect.DeepEqual(segmenter.Boundaries(), []int{0}) {
+		t.Errorf("Invalid boundaries %v with a refusing Cursor", segmenter.Boundaries())
+	}
+
+	expectError(t, iter, ErrCursor)
+
+	// Find the restart position of an edit, and then refuse it
+	segmenter = NewIncrementalSegmenter(NewWordRBBI(), NewStringCursor(str))
+	edited := str[:22] + "n" + str[22:]
+	restart := segmenter.Edit(NewStringCursor(edited), 22, 0, 1).Start
+
+	iter = NewWordRBBI()
+	segmenter = NewIncrementalSegmenter(iter, NewStringCursor(str))
+	segmenter.Edit(&refusingPositionCursor{StringCursor{text: edited}, restart}, 22, 0, 1)
+
+	if boundaries := segmenter.Boundaries(); boundaries[len(boundaries)-1] != restart {
+		t.Errorf("Invalid boundaries %v after an edit with a refusing Cursor", boundaries)
+	}
+
+	expectError(t, iter, ErrCursor)
+}
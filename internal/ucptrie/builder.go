@@ -0,0 +1,439 @@
+// Package ucptrie contains a builder for ICU4C-compatible UCPTrie lookup
+// tables. It is used by the table generators and by the test suite, and is not
+// needed at runtime.
+package ucptrie
+
+import "fmt"
+
+// The type of trie to build. The ordering matches the values in ucptrie.h.
+type Type uint8
+
+const (
+	TypeFast Type = iota
+	TypeSmall
+)
+
+// The width of the values stored in the trie. The ordering matches the values
+// in ucptrie.h.
+type ValueWidth uint8
+
+const (
+	ValueWidth16 ValueWidth = iota
+	ValueWidth32
+	ValueWidth8
+)
+
+// Trie layout constants, identical to the ones used by the lookup code.
+const (
+	fastShift            = 6
+	fastDataBlockLength  = 1 << fastShift
+	fastLimit            = 0x10000
+	smallLimit           = 0x1000
+	bmpIndexLength       = fastLimit >> fastShift
+	smallIndexLength     = smallLimit >> fastShift
+	shift3               = 4
+	shift2               = 5 + shift3
+	shift1               = 5 + shift2
+	omittedBmpIndex1     = fastLimit >> shift1
+	index2BlockLength    = 1 << (shift1 - shift2)
+	index3BlockLength    = 1 << (shift2 - shift3)
+	smallDataBlockLength = 1 << shift3
+	cpPerIndex1Entry     = 1 << shift1
+	cpPerIndex2Entry     = 1 << shift2
+
+	maxCodePoint = 0x10ffff
+
+	// Offsets that are stored when a trie has no null block
+	noIndex3NullOffset = 0x7fff
+	noDataNullOffset   = 0xffff
+)
+
+// A Trie contains the fields of a built trie. The field names mirror the
+// fields of the ucpTrie struct in the rbbi package.
+type Trie struct {
+	Type       Type
+	ValueWidth ValueWidth
+
+	DataLength int32
+
+	Index3NullOffset uint16
+	DataNullOffset   uint16
+
+	HighStart          int32
+	Shifted12HighStart uint32
+	NullValueOffset    uint32
+
+	Index []uint16
+
+	Data8  []uint8
+	Data16 []uint16
+	Data32 []uint32
+
+	NullValue uint32
+}
+
+// A Builder collects a value for every code point, after which it can be
+// turned into a Trie of any type and value width.
+type Builder struct {
+	values     []uint32
+	nullValue  uint32
+	errorValue uint32
+}
+
+// Instantiate a new Builder. All code points are initialized to nullValue. The
+// errorValue is returned by the trie for code points outside of the range
+// U+0000..U+10FFFF.
+func NewBuilder(nullValue, errorValue uint32) *Builder {
+	values := make([]uint32, maxCodePoint+1)
+	for i := range values {
+		values[i] = nullValue
+	}
+
+	return &Builder{
+		values:     values,
+		nullValue:  nullValue,
+		errorValue: errorValue,
+	}
+}
+
+// Return the value for a code point.
+func (b *Builder) Get(codePoint rune) uint32 {
+	if codePoint < 0 || codePoint > maxCodePoint {
+		return b.errorValue
+	}
+
+	return b.values[codePoint]
+}
+
+// Set the value for a single code point.
+func (b *Builder) Set(codePoint rune, value uint32) {
+	b.values[codePoint] = value
+}
+
+// Set the value for an inclusive range of code points.
+func (b *Builder) SetRange(start, end rune, value uint32) {
+	for c := start; c <= end; c++ {
+		b.values[c] = value
+	}
+}
+
+// Build a trie of the requested type and value width. An error is returned
+// when a value does not fit in the value width, or when the data does not fit
+// in the addressable range of the index.
+func (b *Builder) Build(trieType Type, valueWidth ValueWidth) (*Trie, error) {
+	var maxValue uint32
+	switch valueWidth {
+	case ValueWidth8:
+		maxValue = 0xff
+	case ValueWidth16:
+		maxValue = 0xffff
+	default:
+		maxValue = 0xffffffff
+	}
+
+	for c, v := range b.values {
+		if v > maxValue {
+			return nil, fmt.Errorf("value %#x of U+%04X does not fit in the value width", v, c)
+		}
+	}
+
+	if b.errorValue > maxValue {
+		return nil, fmt.Errorf("error value %#x does not fit in the value width", b.errorValue)
+	}
+
+	limit := int32(fastLimit)
+	indexLength := int32(bmpIndexLength)
+	if trieType == TypeSmall {
+		limit = smallLimit
+		indexLength = smallIndexLength
+	}
+
+	// All code points at and above highStart share the value of U+10FFFF.
+	// Round it up so that it covers whole index-3 blocks.
+	highValue := b.values[maxCodePoint]
+	highStart := int32(maxCodePoint + 1)
+	for highStart > limit && b.values[highStart-1] == highValue {
+		highStart--
+	}
+	highStart = (highStart + cpPerIndex2Entry - 1) &^ (cpPerIndex2Entry - 1)
+
+	var data []uint32
+
+	// The first block containing only null values is the data null block. It
+	// is shared by all shorter null blocks, so that every block at its offset
+	// contains only null values.
+	nullOffset := int32(-1)
+	nullLength := 0
+
+	addBlock := func(values []uint32) int32 {
+		null := b.isNull(values)
+		if null && nullOffset >= 0 && len(values) <= nullLength {
+			return nullOffset
+		}
+
+		// Reuse identical values anywhere in the data, or else append the
+		// block, overlapping the end of the data as far as possible
+		offset := findBlock(data, values)
+		if offset < 0 {
+			overlap := blockOverlap(data, values)
+			offset = int32(len(data) - overlap)
+			data = append(data, values[overlap:]...)
+		}
+
+		if null && nullOffset < 0 {
+			nullOffset = offset
+			nullLength = len(values)
+		}
+
+		return offset
+	}
+
+	index := make([]uint16, indexLength)
+	for c := int32(0); c < limit; c += fastDataBlockLength {
+		offset := addBlock(b.values[c : c+fastDataBlockLength])
+		if offset > 0xffff {
+			return nil, fmt.Errorf("fast data offset %#x is out of range", offset)
+		}
+
+		index[c>>fastShift] = uint16(offset)
+	}
+
+	// Reserve space for the index-1 table. For fast tries the part of the
+	// index-1 table that covers the BMP is omitted.
+	index1Start := int32(len(index))
+	index1Length := (highStart + cpPerIndex1Entry - 1) >> shift1
+	if trieType == TypeFast {
+		index1Length -= omittedBmpIndex1
+		if index1Length < 0 {
+			index1Length = 0
+		}
+	}
+	index = append(index, make([]uint16, index1Length)...)
+
+	// Index-2 and index-3 blocks are shared like data blocks, but only
+	// within the part of the index following the index-1 table
+	indexBlocksStart := len(index)
+	addIndexBlock := func(block []uint16) uint16 {
+		if offset := findIndexBlock(index[indexBlocksStart:], block); offset >= 0 {
+			return uint16(indexBlocksStart + offset)
+		}
+
+		overlap := indexBlockOverlap(index[indexBlocksStart:], block)
+		position := uint16(len(index) - overlap)
+		index = append(index, block[overlap:]...)
+
+		return position
+	}
+
+	for i1 := int32(0); i1 < index1Length; i1++ {
+		blockStart := i1 << shift1
+		if trieType == TypeFast {
+			blockStart += fastLimit
+		}
+
+		index2 := make([]uint16, index2BlockLength)
+		for i2 := int32(0); i2 < index2BlockLength; i2++ {
+			start := blockStart + i2<<shift2
+
+			offsets := make([]int32, index3BlockLength)
+			wide := false
+			for i3 := int32(0); i3 < index3BlockLength; i3++ {
+				c := start + i3<<shift3
+				if c < limit || c >= highStart {
+					// Never looked up, point it at any valid block.
+					offsets[i3] = 0
+					continue
+				}
+
+				offsets[i3] = addBlock(b.values[c : c+smallDataBlockLength])
+				if offsets[i3] > 0xffff {
+					wide = true
+				}
+			}
+
+			position := addIndexBlock(encodeIndex3(offsets, wide))
+
+			if wide {
+				index2[i2] = 0x8000 | position
+			} else {
+				index2[i2] = position
+			}
+		}
+
+		index[index1Start+i1] = addIndexBlock(index2)
+
+		if len(index) >= 0x8000 {
+			return nil, fmt.Errorf("index length %d is out of range", len(index))
+		}
+	}
+
+	// Locate the null blocks, which are used to skip over unassigned ranges.
+	dataNullOffset := int32(noDataNullOffset)
+	if nullOffset >= 0 && nullOffset < noDataNullOffset {
+		dataNullOffset = nullOffset
+	}
+
+	index3NullOffset := int32(noIndex3NullOffset)
+	if dataNullOffset != noDataNullOffset {
+		nullOffsets := make([]int32, index3BlockLength)
+		for i := range nullOffsets {
+			nullOffsets[i] = dataNullOffset
+		}
+		if offset := findIndexBlock(index[indexBlocksStart:], encodeIndex3(nullOffsets, false)); offset >= 0 {
+			index3NullOffset = int32(indexBlocksStart + offset)
+		}
+	}
+
+	data = append(data, highValue, b.errorValue)
+
+	trie := &Trie{
+		Type:               trieType,
+		ValueWidth:         valueWidth,
+		DataLength:         int32(len(data)),
+		Index3NullOffset:   uint16(index3NullOffset),
+		DataNullOffset:     uint16(dataNullOffset),
+		HighStart:          highStart,
+		Shifted12HighStart: uint32(highStart+0xfff) >> 12,
+		NullValueOffset:    uint32(dataNullOffset),
+		Index:              index,
+		NullValue:          b.nullValue,
+	}
+
+	switch valueWidth {
+	case ValueWidth8:
+		trie.Data8 = make([]uint8, len(data))
+		for i, v := range data {
+			trie.Data8[i] = uint8(v)
+		}
+	case ValueWidth16:
+		trie.Data16 = make([]uint16, len(data))
+		for i, v := range data {
+			trie.Data16[i] = uint16(v)
+		}
+	default:
+		trie.Data32 = data
+	}
+
+	return trie, nil
+}
+
+// Return whether a block contains only null values.
+func (b *Builder) isNull(values []uint32) bool {
+	for _, value := range values {
+		if value != b.nullValue {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Encode the data block offsets of an index-3 block. Offsets that fit in 16
+// bits are stored as is. Otherwise the block is stored in groups of 9 entries
+// per 8 offsets, where the first entry of each group holds the upper 2 bits of
+// the 18-bit offsets.
+func encodeIndex3(offsets []int32, wide bool) []uint16 {
+	if !wide {
+		block := make([]uint16, len(offsets))
+		for i, offset := range offsets {
+			block[i] = uint16(offset)
+		}
+
+		return block
+	}
+
+	block := make([]uint16, 0, len(offsets)/8*9)
+	for group := 0; group < len(offsets); group += 8 {
+		var upper uint16
+		for i := 0; i < 8; i++ {
+			upper |= uint16((offsets[group+i]>>16)&3) << (14 - 2*i)
+		}
+
+		block = append(block, upper)
+		for i := 0; i < 8; i++ {
+			block = append(block, uint16(offsets[group+i]))
+		}
+	}
+
+	return block
+}
+
+// Return the offset of the first occurrence of a block of values in the data,
+// or -1 when it does not occur.
+func findBlock(data, values []uint32) int32 {
+	for offset := 0; offset+len(values) <= len(data); offset++ {
+		if equalValues(data[offset:offset+len(values)], values) {
+			return int32(offset)
+		}
+	}
+
+	return -1
+}
+
+// Return the length of the longest prefix of a block of values that matches
+// the end of the data, and can therefore be shared.
+func blockOverlap(data, values []uint32) int {
+	overlap := len(values) - 1
+	if overlap > len(data) {
+		overlap = len(data)
+	}
+
+	for ; overlap > 0; overlap-- {
+		if equalValues(data[len(data)-overlap:], values[:overlap]) {
+			break
+		}
+	}
+
+	return overlap
+}
+
+// Return whether two slices of values are equal.
+func equalValues(a, b []uint32) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Return the offset of the first occurrence of an index block in the index,
+// or -1 when it does not occur.
+func findIndexBlock(index, block []uint16) int {
+	for offset := 0; offset+len(block) <= len(index); offset++ {
+		if equalIndexBlocks(index[offset:offset+len(block)], block) {
+			return offset
+		}
+	}
+
+	return -1
+}
+
+// Return the length of the longest prefix of an index block that matches the
+// end of the index.
+func indexBlockOverlap(index, block []uint16) int {
+	overlap := len(block) - 1
+	if overlap > len(index) {
+		overlap = len(index)
+	}
+
+	for ; overlap > 0; overlap-- {
+		if equalIndexBlocks(index[len(index)-overlap:], block[:overlap]) {
+			break
+		}
+	}
+
+	return overlap
+}
+
+// Return whether two index blocks are equal.
+func equalIndexBlocks(a, b []uint16) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
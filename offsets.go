@@ -0,0 +1,354 @@
+package rbbi
+
+import (
+	"sort"
+	"sync"
+	"unicode/utf8"
+)
+
+// The OffsetUnit is the unit in which an offset into a text is measured.
+type OffsetUnit int
+
+const (
+	// Bytes of the UTF-8 encoding, as used by StringCursor and Go strings
+	UnitByte OffsetUnit = iota
+
+	// Runes (code points), as used by Python and rune slices
+	UnitRune
+
+	// UTF-16 code units, as used by JavaScript, Java and the Language Server
+	// Protocol
+	UnitUTF16
+
+	// Grapheme clusters (user-perceived characters)
+	UnitGrapheme
+)
+
+// Return the name of the unit.
+func (u OffsetUnit) String() string {
+	switch u {
+	case UnitByte:
+		return "byte"
+	case UnitRune:
+		return "rune"
+	case UnitUTF16:
+		return "UTF-16"
+	case UnitGrapheme:
+		return "grapheme"
+	}
+
+	return "unknown"
+}
+
+// The number of runes between the checkpoints of an OffsetMap. A conversion
+// decodes at most this many runes after a binary search of the checkpoints.
+const offsetMapStride = 64
+
+// The offsets of a rune in every unit except grapheme clusters.
+type offsetCheckpoint struct {
+	byteOffset  int
+	runeOffset  int
+	utf16Offset int
+}
+
+// An OffsetMap converts offsets into a text between bytes, runes, UTF-16 code
+// units and grapheme clusters. It keeps checkpoints of the offsets for
+// repeated conversions, and the grapheme cluster boundaries once they are
+// first needed. An OffsetMap is safe for concurrent use by multiple
+// goroutines, except for SetAlignmentPolicy().
+//
+// Runes are decoded like StringCursor decodes them, so every byte that is not
+// part of a valid UTF-8 sequence counts as a rune of its own, and as a single
+// UTF-16 code unit.
+type OffsetMap struct {
+	text string
+
+	alignment AlignmentPolicy
+
+	// Whether the text consists of ASCII only, in which case the offsets
+	// are the same in every unit except grapheme clusters and there are no
+	// checkpoints
+	ascii bool
+
+	// Checkpoints at every offsetMapStride runes, starting at 0
+	checkpoints []offsetCheckpoint
+
+	runeCount  int
+	utf16Count int
+
+	graphemeOnce sync.Once
+	graphemes    []int
+	graphemeErr  error
+}
+
+// Instantiate a new OffsetMap for the provided text.
+func NewOffsetMap(text string) *OffsetMap {
+	m := &OffsetMap{
+		text:  text,
+		ascii: true,
+	}
+
+	for i := 0; i < len(text); i++ {
+		if text[i] >= utf8.RuneSelf {
+			m.ascii = false
+			break
+		}
+	}
+
+	if m.ascii {
+		m.runeCount = len(text)
+		m.utf16Count = len(text)
+		return m
+	}
+
+	m.checkpoints = make([]offsetCheckpoint, 0, utf8.RuneCountInString(text)/offsetMapStride+1)
+
+	var current offsetCheckpoint
+	for {
+		if current.runeOffset%offsetMapStride == 0 {
+			m.checkpoints = append(m.checkpoints, current)
+		}
+
+		if current.byteOffset == len(text) {
+			break
+		}
+
+		current = m.advance(current)
+	}
+
+	m.runeCount = current.runeOffset
+	m.utf16Count = current.utf16Offset
+
+	return m
+}
+
+// Set the AlignmentPolicy for offsets that are not at a boundary of the unit
+// they are converted to, such as a UTF-16 offset between the two code units
+// of a surrogate pair or a byte offset inside a grapheme cluster that is
+// converted to graphemes. By default such offsets are rejected with
+// ErrMisalignedPosition.
+func (m *OffsetMap) SetAlignmentPolicy(policy AlignmentPolicy) {
+	m.alignment = policy
+}
+
+// Return the text of the OffsetMap.
+func (m *OffsetMap) Text() string {
+	return m.text
+}
+
+// Return the length of the text in the provided unit.
+func (m *OffsetMap) Len(unit OffsetUnit) int {
+	switch unit {
+	case UnitByte:
+		return len(m.text)
+	case UnitRune:
+		return m.runeCount
+	case UnitUTF16:
+		return m.utf16Count
+	case UnitGrapheme:
+		if graphemes, err := m.graphemeBoundaries(); err == nil {
+			return len(graphemes) - 1
+		}
+	}
+
+	return -1
+}
+
+// Convert an offset from one unit to another. Returns ErrPositionOutOfRange
+// when the offset is negative or beyond the end of the text. An offset that
+// is not at a boundary of both units is handled according to the
+// AlignmentPolicy.
+func (m *OffsetMap) Convert(offset int, from, to OffsetUnit) (int, error) {
+	position, err := m.byteOffset(offset, from)
+	if err != nil {
+		return -1, err
+	}
+
+	return m.unitOffset(position, to)
+}
+
+// Return the first boundary following an offset, like RBBI.Following(), with
+// both the offset and the boundary measured in the provided unit. The Cursor
+// of the break iterator must use byte offsets into the text of the OffsetMap,
+// like a StringCursor or a RopeCursor for the same text. Conversion errors are
+// recorded by the break iterator, and returned by its Err() function.
+func (m *OffsetMap) Following(iter *RBBI, offset int, unit OffsetUnit) (int, bool) {
+	return m.boundary(iter, offset, unit, (*RBBI).Following)
+}
+
+// Return the last boundary preceding an offset, like RBBI.Preceding(), with
+// both the offset and the boundary measured in the provided unit. See
+// Following() for the requirements on the break iterator.
+func (m *OffsetMap) Preceding(iter *RBBI, offset int, unit OffsetUnit) (int, bool) {
+	return m.boundary(iter, offset, unit, (*RBBI).Preceding)
+}
+
+// Return the boundary found by find, a method such as RBBI.Following(), with
+// both the offset and the boundary measured in the provided unit. Conversion
+// errors are recorded in the break iterator.
+func (m *OffsetMap) boundary(iter *RBBI, offset int, unit OffsetUnit, find func(*RBBI, int) (int, bool)) (int, bool) {
+	if iter.err != nil {
+		return -1, false
+	}
+
+	position, err := m.byteOffset(offset, unit)
+	if err != nil {
+		return iter.result(-1, false, err)
+	}
+
+	boundary, ok := find(iter, position)
+	if !ok {
+		return -1, false
+	}
+
+	offset, err = m.unitOffset(boundary, unit)
+	return iter.result(offset, true, err)
+}
+
+// Convert an offset in the provided unit to a byte offset, which is aligned
+// to a rune boundary.
+func (m *OffsetMap) byteOffset(offset int, unit OffsetUnit) (int, error) {
+	if offset < 0 || offset > m.Len(unit) {
+		return -1, ErrPositionOutOfRange
+	}
+
+	switch unit {
+	case UnitByte:
+		return m.alignment.align(m.text, offset)
+
+	case UnitRune:
+		if m.ascii {
+			return offset, nil
+		}
+
+		current := m.checkpoints[offset/offsetMapStride]
+		for current.runeOffset < offset {
+			current = m.advance(current)
+		}
+
+		return current.byteOffset, nil
+
+	case UnitUTF16:
+		if m.ascii {
+			return offset, nil
+		}
+
+		i := sort.Search(len(m.checkpoints), func(i int) bool {
+			return m.checkpoints[i].utf16Offset > offset
+		})
+
+		current := m.checkpoints[i-1]
+		for current.utf16Offset < offset {
+			next := m.advance(current)
+			if next.utf16Offset > offset {
+				// The offset is between the code units of a surrogate pair
+				return m.alignBetween(current.byteOffset, next.byteOffset)
+			}
+
+			current = next
+		}
+
+		return current.byteOffset, nil
+
+	case UnitGrapheme:
+		graphemes, err := m.graphemeBoundaries()
+		if err != nil {
+			return -1, err
+		}
+
+		return graphemes[offset], nil
+	}
+
+	return -1, ErrPositionOutOfRange
+}
+
+// Convert a byte offset at a rune boundary to the provided unit.
+func (m *OffsetMap) unitOffset(position int, unit OffsetUnit) (int, error) {
+	switch unit {
+	case UnitByte:
+		return position, nil
+
+	case UnitRune, UnitUTF16:
+		if m.ascii {
+			return position, nil
+		}
+
+		i := sort.Search(len(m.checkpoints), func(i int) bool {
+			return m.checkpoints[i].byteOffset > position
+		})
+
+		current := m.checkpoints[i-1]
+		for current.byteOffset < position {
+			current = m.advance(current)
+		}
+
+		if unit == UnitRune {
+			return current.runeOffset, nil
+		}
+
+		return current.utf16Offset, nil
+
+	case UnitGrapheme:
+		graphemes, err := m.graphemeBoundaries()
+		if err != nil {
+			return -1, err
+		}
+
+		i := sort.SearchInts(graphemes, position)
+		if graphemes[i] == position {
+			return i, nil
+		}
+
+		// The position is inside the grapheme cluster ending at
+		// graphemes[i]
+		offset, err := m.alignBetween(i-1, i)
+		if err != nil {
+			return -1, err
+		}
+
+		return offset, nil
+	}
+
+	return -1, ErrPositionOutOfRange
+}
+
+// Return the offset of the next rune following a checkpoint.
+func (m *OffsetMap) advance(current offsetCheckpoint) offsetCheckpoint {
+	_, size := utf8.DecodeRuneInString(m.text[current.byteOffset:])
+
+	current.byteOffset += size
+	current.runeOffset++
+	current.utf16Offset++
+
+	// Runes outside the BMP are encoded as a surrogate pair
+	if size == utf8.UTFMax {
+		current.utf16Offset++
+	}
+
+	return current
+}
+
+// Align an offset that is strictly between two boundaries according to the
+// AlignmentPolicy.
+func (m *OffsetMap) alignBetween(before, after int) (int, error) {
+	switch m.alignment {
+	case AlignmentSnapBackward:
+		return before, nil
+	case AlignmentSnapForward:
+		return after, nil
+	}
+
+	return -1, ErrMisalignedPosition
+}
+
+// Return the byte offsets of the grapheme cluster boundaries, including 0 and
+// the length of the text, which are computed once.
+func (m *OffsetMap) graphemeBoundaries() ([]int, error) {
+	m.graphemeOnce.Do(func() {
+		iter := NewCharacterRBBI()
+		m.graphemes = iter.AppendBoundaries(nil, m.text)
+		m.graphemeErr = iter.Err()
+	})
+
+	return m.graphemes, m.graphemeErr
+}
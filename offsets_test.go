@@ -0,0 +1,209 @@
+package rbbi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+var offsetTexts = []string{
+	"",
+	"Hello, world!",
+	"Größe 🐨🏴‍☠️ é 日本語",
+	"\xffa\xe2\x82b😀",
+	strings.Repeat("a😀é\U0001F1F3\U0001F1F1 ", 50),
+}
+
+// The offsets of a rune boundary in every unit, computed without an
+// OffsetMap. The grapheme offset is -1 when the rune boundary is not a
+// grapheme cluster boundary.
+type referenceOffsets [4]int
+
+func referenceOffsetsOf(text string) []referenceOffsets {
+	graphemes := map[int]int{0: 0}
+	position := 0
+	for i, grapheme := range Graphemes(text) {
+		position += len(grapheme)
+		graphemes[position] = i + 1
+	}
+
+	var result []referenceOffsets
+	var current referenceOffsets
+
+	for {
+		current[UnitGrapheme] = -1
+		if index, ok := graphemes[current[UnitByte]]; ok {
+			current[UnitGrapheme] = index
+		}
+
+		result = append(result, current)
+
+		if current[UnitByte] == len(text) {
+			return result
+		}
+
+		c, size := utf8.DecodeRuneInString(text[current[UnitByte]:])
+		current[UnitByte] += size
+		current[UnitRune]++
+		current[UnitUTF16]++
+
+		if c > 0xffff {
+			current[UnitUTF16]++
+		}
+	}
+}
+
+func TestOffsetMapConvert(t *testing.T) {
+	units := []OffsetUnit{UnitByte, UnitRune, UnitUTF16, UnitGrapheme}
+
+	for _, text := range offsetTexts {
+		m := NewOffsetMap(text)
+		reference := referenceOffsetsOf(text)
+		last := reference[len(reference)-1]
+
+		for _, unit := range units {
+			if m.Len(unit) != last[unit] {
+				t.Errorf("Invalid %v length %v of %q, expected %v", unit, m.Len(unit), text, last[unit])
+			}
+		}
+
+		for _, offsets := range reference {
+			for _, from := range units {
+				if offsets[from] < 0 {
+					continue
+				}
+
+				for _, to := range units {
+					if offsets[to] < 0 {
+						continue
+					}
+
+					if result, err := m.Convert(offsets[from], from, to); err != nil || result != offsets[to] {
+						t.Errorf("Converting %v offset %v of %q to %v gave %v (%v), expected %v", from, offsets[from], text, to, result, err, offsets[to])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestOffsetMapAlignment(t *testing.T) {
+	// The koala is 4 bytes and 2 UTF-16 code units, and the accented e is
+	// a grapheme cluster of 2 runes
+	m := NewOffsetMap("a🐨e\u0301b")
+
+	tests := []struct {
+		offset   int
+		from, to OffsetUnit
+		backward int
+		forward  int
+	}{
+		{2, UnitUTF16, UnitByte, 1, 5},
+		{2, UnitByte, UnitRune, 1, 2},
+		{3, UnitRune, UnitGrapheme, 2, 3},
+		{7, UnitByte, UnitGrapheme, 2, 3},
+	}
+
+	for _, test := range tests {
+		if _, err := m.Convert(test.offset, test.from, test.to); !errors.Is(err, ErrMisalignedPosition) {
+			t.Errorf("Converting %v offset %v to %v gave error %v", test.from, test.offset, test.to, err)
+		}
+
+		for policy, expected := range map[AlignmentPolicy]int{AlignmentSnapBackward: test.backward, AlignmentSnapForward: test.forward} {
+			m.SetAlignmentPolicy(policy)
+
+			if result, err := m.Convert(test.offset, test.from, test.to); err != nil || result != expected {
+				t.Errorf("Converting %v offset %v to %v with policy %v gave %v (%v), expected %v", test.from, test.offset, test.to, policy, result, err, expected)
+			}
+		}
+
+		m.SetAlignmentPolicy(AlignmentReject)
+	}
+}
+
+func TestOffsetMapOutOfRange(t *testing.T) {
+	m := NewOffsetMap("a🐨")
+
+	for _, test := range []struct {
+		offset int
+		unit   OffsetUnit
+	}{
+		{-1, UnitByte},
+		{6, UnitByte},
+		{3, UnitRune},
+		{4, UnitUTF16},
+		{3, UnitGrapheme},
+		{0, OffsetUnit(10)},
+	} {
+		if _, err := m.Convert(test.offset, test.unit, UnitByte); !errors.Is(err, ErrPositionOutOfRange) {
+			t.Errorf("Converting %v offset %v gave error %v", test.unit, test.offset, err)
+		}
+	}
+}
+
+func TestOffsetMapBoundaries(t *testing.T) {
+	text := offsetTexts[2]
+	m := NewOffsetMap(text)
+
+	iter := NewWordRBBI()
+	iter.SetCursor(NewStringCursor(text))
+
+	reference := NewWordRBBI()
+	reference.SetCursor(NewStringCursor(text))
+
+	for _, offsets := range referenceOffsetsOf(text) {
+		expected, expectedOk := reference.Following(offsets[UnitByte])
+		expected16, _ := m.Convert(expected, UnitByte, UnitUTF16)
+
+		if boundary, ok := m.Following(iter, offsets[UnitUTF16], UnitUTF16); ok != expectedOk || (ok && boundary != expected16) {
+			t.Errorf("Invalid following boundary %v of UTF-16 offset %v, expected %v", boundary, offsets[UnitUTF16], expected16)
+		}
+
+		expected, expectedOk = reference.Preceding(offsets[UnitByte])
+		expected16, _ = m.Convert(expected, UnitByte, UnitUTF16)
+
+		if boundary, ok := m.Preceding(iter, offsets[UnitUTF16], UnitUTF16); ok != expectedOk || (ok && boundary != expected16) {
+			t.Errorf("Invalid preceding boundary %v of UTF-16 offset %v, expected %v", boundary, offsets[UnitUTF16], expected16)
+		}
+	}
+
+	if iter.Err() != nil {
+		t.Errorf("Unexpected error %v", iter.Err())
+	}
+}
+
+func TestOffsetMapBoundariesError(t *testing.T) {
+	m := NewOffsetMap("a🐨b")
+
+	iter := NewCharacterRBBI()
+	iter.SetCursor(NewStringCursor(m.Text()))
+
+	if _, ok := m.Following(iter, 2, UnitUTF16); ok || !errors.Is(iter.Err(), ErrMisalignedPosition) {
+		t.Errorf("Following a misaligned offset gave error %v", iter.Err())
+	}
+
+	// The error is sticky until the Cursor is replaced
+	if _, ok := m.Preceding(iter, 3, UnitUTF16); ok {
+		t.Error("Preceding was ok after an error")
+	}
+
+	iter.SetCursor(NewStringCursor(m.Text()))
+	if boundary, ok := m.Preceding(iter, 3, UnitUTF16); !ok || boundary != 1 {
+		t.Errorf("Invalid preceding boundary %v", boundary)
+	}
+}
+
+func BenchmarkOffsetMapConvert(b *testing.B) {
+	text := strings.Repeat("a😀é\U0001F1F3\U0001F1F1 ", 1000)
+	m := NewOffsetMap(text)
+	length := m.Len(UnitUTF16)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := m.Convert((i*7919)%length, UnitUTF16, UnitByte); err != nil && !errors.Is(err, ErrMisalignedPosition) {
+			b.Fatal(err)
+		}
+	}
+}
@@ -3,6 +3,10 @@
 // sentence, and word) from unicode strings.
 package rbbi
 
+import (
+	"errors"
+)
+
 // A struct representing the Unicode rule-based break iterator (RBBI). This
 // struct encapsulates a state machine and lookup tables to detect various
 // kinds of breaks in unicode strings.
@@ -12,6 +16,9 @@ package rbbi
 // many different data structures, as long as they have the ability to yield
 // runes and detect start/end of string boundaries.
 type RBBI struct {
+	rules *Rules
+
+	// Shortcut to the data of the rules
 	data *rbbiData
 
 	lookaheadMatches []int
@@ -19,42 +26,60 @@ type RBBI struct {
 
 	// Text that is iterated over
 	cursor Cursor
-}
 
-func newRBBI(data *rbbiData) *RBBI {
-	return &RBBI{
-		data: data,
+	// The error that stopped iteration, if any
+	err error
 
-		lookaheadMatches: make([]int, data.forwardTable.lookaheadResultsSize),
-	}
+	// The optional hook for tracing the state machines
+	tracer Tracer
 }
 
 // Instantiate a new rule-based break iterator for detecting character
 // (grapheme cluster) breaks.
 func NewCharacterRBBI() *RBBI {
-	return newRBBI(&rbbiCharacterData)
+	return CharacterRules().NewRBBI()
 }
 
 // Instantiate a new rule-based break iterator for detecting line breaks (for
 // use with word wrapping).
 func NewLineRBBI() *RBBI {
-	return newRBBI(&rbbiLineData)
+	return LineRules().NewRBBI()
 }
 
 // Instantiate a new rule-based break iterator for detecting sentence braeks.
 func NewSentenceRBBI() *RBBI {
-	return newRBBI(&rbbiSentenceData)
+	return SentenceRules().NewRBBI()
 }
 
 // Instantiate a new rule-based break iterator for detecting word boundary
 // breaks (e.g. for selecting a word by double-clicking).
 func NewWordRBBI() *RBBI {
-	return newRBBI(&rbbiWordData)
+	return WordRules().NewRBBI()
 }
 
-// Assign a new Cursor to the break iterator.
+// Return the rules used by the break iterator.
+func (r *RBBI) Rules() *Rules {
+	return r.rules
+}
+
+// Return a copy of the break iterator that shares its immutable rules, similar
+// to ICU's createBufferClone(). The copy has its own iteration state, so it
+// can be used by another goroutine. Because Cursors are stateful they can not
+// be shared, so the copy has no Cursor assigned and one must be provided
+// using SetCursor() before iterating. The Tracer is not shared either, so the
+// copy is only traced after assigning one using SetTracer().
+func (r *RBBI) Clone() *RBBI {
+	clone := r.rules.NewRBBI()
+	clone.ruleStatusIndex = r.ruleStatusIndex
+
+	return clone
+}
+
+// Assign a new Cursor to the break iterator. This also clears the error
+// returned by Err().
 func (r *RBBI) SetCursor(cursor Cursor) {
 	r.cursor = cursor
+	r.err = nil
 
 	// TODO: Invalidate break/dictionary caches
 	// TODO: Call First()
@@ -84,6 +109,22 @@ const (
 	rbbiRunModeEnd
 )
 
+// Report a step of a state machine to the Tracer.
+func (r *RBBI) trace(table TraceTable, step int, c rune, category uint32, state, nextState int32, accepting, lookahead int) {
+	r.tracer(TraceStep{
+		Table:           table,
+		Index:           step,
+		Position:        r.cursor.Position(),
+		Rune:            c,
+		Category:        int(category),
+		State:           int(state),
+		NextState:       int(nextState),
+		Accepting:       accepting,
+		Lookahead:       lookahead,
+		RuleStatusIndex: int(r.ruleStatusIndex),
+	})
+}
+
 // Scan runes from the Cursor (in the forward direction) and stop at the next
 // break. Returns a (position, ok) tuple after scanning. The value of ok is
 // false when the iterator tried to scan beyond the end of the string. In any
@@ -93,9 +134,65 @@ const (
 // iteration sequence.
 //
 // On failure the Cursor is reset to the position it had at the start of the
-// Next() call.
+// Next() call. The value of ok is also false when iteration was stopped by an
+// error, which can be retrieved using Err().
 func (r *RBBI) Next() (position int, ok bool) {
-	var category uint16 = 0
+	if r.err != nil {
+		return -1, false
+	}
+
+	return r.result(r.handleNext())
+}
+
+// Return the error that stopped iteration, or nil when no error occurred. An
+// error wraps either ErrCorruptTable or ErrCursor, and can be tested using
+// errors.Is(). Once an error occurred all iteration functions report that
+// there are no more breaks, until a new Cursor is assigned using SetCursor().
+func (r *RBBI) Err() error {
+	return r.err
+}
+
+// Record the error of an internal iteration function, and convert its results
+// to the (position, ok) tuple returned by the exported iteration functions.
+func (r *RBBI) result(position int, ok bool, err error) (int, bool) {
+	if err != nil {
+		r.err = err
+		return -1, false
+	}
+
+	return position, ok
+}
+
+// Set the position of the Cursor. Returns a CursorError wrapping the error of
+// the Cursor when it refuses the position.
+func (r *RBBI) setPosition(position int) error {
+	if err := r.cursor.SetPosition(position); err != nil {
+		return &CursorError{Position: position, Err: err}
+	}
+
+	return nil
+}
+
+// Return the category of a rune, as used for selecting a column of the state
+// tables. Returns an error wrapping ErrCorruptTable when the category does not
+// exist.
+func (r *RBBI) category(c rune) (uint32, error) {
+	category, err := r.data.trie.get(c)
+	if err != nil {
+		return 0, err
+	}
+
+	if category >= r.data.categoryCount {
+		return 0, corruptTableError("category %v of rune %U is out of range", category, c)
+	}
+
+	return category, nil
+}
+
+// The implementation of Next(), which reports errors instead of recording
+// them.
+func (r *RBBI) handleNext() (position int, ok bool, err error) {
+	var category uint32 = 0
 
 	// handleNext always sets the break tag value.
 	// Set the default for it.
@@ -104,20 +201,52 @@ func (r *RBBI) Next() (position int, ok bool) {
 	// TODO: Figure out what this is used for
 	fDictionaryCharCount := 0
 
-	initialPosition := r.cursor.Position()
-	result := initialPosition
+	// The tables must be consistent, so that the state machine does not need
+	// to check them
+	if err := r.rules.validate(); err != nil {
+		return -1, false, err
+	}
+
+	// When the Cursor is a StringCursor, runes are decoded directly from its
+	// string instead of through the Cursor interface.
+	direct, isDirect := r.cursor.(*StringCursor)
+
+	var initialPosition int
+	var c rune
+	var nextOk bool
 
 	// Grab the next rune
-	c, nextOk := r.cursor.Next()
+	if isDirect {
+		initialPosition = direct.position
+		c, nextOk = direct.Next()
+	} else {
+		initialPosition = r.cursor.Position()
+		c, nextOk = r.cursor.Next()
+	}
+
+	result := initialPosition
 
 	// If we're already at the end of the text, return DONE.
 	if !nextOk {
-		return -1, false
+		return -1, false, nil
 	}
 
+	// Lookahead results from a previous call do not apply
+	for i := range r.lookaheadMatches {
+		r.lookaheadMatches[i] = -1
+	}
+
+	// Categories of the Latin-1 code points, which make up most text
+	latin1Categories := &r.rules.latin1Categories
+
 	// Set the initial state for the state machine
 	state := rbbiStateStart
-	row := r.data.forwardTable.rows[state]
+	table := &r.data.forwardTable
+	rowLength := int(table.rowLength)
+	row := int(state) * rowLength
+
+	// The number of the step, for tracing
+	step := 0
 
 	mode := rbbiRunModeRun
 	if r.data.forwardTable.bofRequired {
@@ -148,67 +277,77 @@ func (r *RBBI) Next() (position int, ok bool) {
 		if mode == rbbiRunModeRun {
 			// Look up the current character's character category, which tells
 			// us which column in the state table to look at.
-			category = uint16(r.data.trie.fastGet(c))
+			if c >= 0 && c < 256 {
+				category = uint32(latin1Categories[c])
+			} else {
+				category, err = r.category(c)
+				if err != nil {
+					return -1, false, err
+				}
+			}
 
-			if uint32(category) >= r.data.forwardTable.dictCategoriesStart {
+			if category >= r.data.forwardTable.dictCategoriesStart {
 				fDictionaryCharCount++
 			}
 		}
 
 		// State Transition - move machine to its next state
+		previousState := state
+		state = int32(table.value(row + rbbiRowNextStates + int(category)))
+		row = int(state) * rowLength
 
-		// fNextState is a variable-length array.
-		if uint32(category) >= r.data.categoryCount {
-			//U_ASSERT(category < fData->fHeader->fCatCount)
-			panic("Assertion error")
-		}
-
-		state = int32(row.nextStates[category])
-		row = r.data.forwardTable.rows[state]
+		accepting := table.value(row + rbbiRowAccepting)
+		rule := table.value(row + rbbiRowLookahead)
 
-		accepting := int16(row.accepting)
-		if accepting == rbbiAcceptingUnconditional {
+		lookaheadResult := -1
+		if accepting == int(rbbiAcceptingUnconditional) {
 			// Match found, common case.
 			if mode != rbbiRunModeStart {
-				result = r.cursor.Position()
+				if isDirect {
+					result = direct.position
+				} else {
+					result = r.cursor.Position()
+				}
 			}
 
 			// Remember the break status (tag) values.
-			r.ruleStatusIndex = int32(row.tagIndex)
-		} else if accepting > rbbiAcceptingUnconditional {
+			r.ruleStatusIndex = int32(table.value(row + rbbiRowTagIndex))
+		} else if accepting > int(rbbiAcceptingUnconditional) {
 			// Lookahead match is completed.
-			if uint32(accepting) >= r.data.forwardTable.lookaheadResultsSize {
-				//U_ASSERT(accepting < fData->fForwardTable->fLookAheadResultsSize);
-				panic("Assertion error")
-			}
-
-			lookaheadResult := r.lookaheadMatches[accepting]
+			lookaheadResult = r.lookaheadMatches[accepting]
 
 			if lookaheadResult >= 0 {
-				r.ruleStatusIndex = int32(row.tagIndex)
-				r.cursor.SetPosition(int(lookaheadResult))
-
-				return int(lookaheadResult), true
+				r.ruleStatusIndex = int32(table.value(row + rbbiRowTagIndex))
 			}
 		}
 
-		// If we are at the position of the '/' in a look-ahead (hard break)
-		// rule; record the current position, to be returned later, if the full
-		// rule matches.
-		rule := row.lookahead
+		if r.tracer != nil {
+			traced := c
+			if mode != rbbiRunModeRun {
+				traced = -1
+			}
 
-		if rule != 0 && int16(rule) <= rbbiAcceptingUnconditional {
-			//U_ASSERT(rule == 0 || rule > ACCEPTING_UNCONDITIONAL);
-			panic("Assertion failure")
+			r.trace(TraceForward, step, traced, category, previousState, state, accepting, rule)
+			step++
 		}
 
-		if rule != 0 && uint32(rule) >= r.data.forwardTable.lookaheadResultsSize {
-			//U_ASSERT(rule == 0 || rule < fData->fForwardTable->fLookAheadResultsSize);
-			panic("Assertion failure")
+		if lookaheadResult >= 0 {
+			if err := r.setPosition(lookaheadResult); err != nil {
+				return -1, false, err
+			}
+
+			return lookaheadResult, true, nil
 		}
 
-		if int16(rule) > rbbiAcceptingUnconditional {
-			r.lookaheadMatches[rule] = r.cursor.Position()
+		// If we are at the position of the '/' in a look-ahead (hard break)
+		// rule; record the current position, to be returned later, if the full
+		// rule matches.
+		if rule > int(rbbiAcceptingUnconditional) {
+			if isDirect {
+				r.lookaheadMatches[rule] = direct.position
+			} else {
+				r.lookaheadMatches[rule] = r.cursor.Position()
+			}
 		}
 
 		if state == rbbiStateStop {
@@ -222,7 +361,11 @@ func (r *RBBI) Next() (position int, ok bool) {
 		// iteration, don't advance the input position. The next iteration will
 		// be processing the first real input character.
 		if mode == rbbiRunModeRun {
-			c, nextOk = r.cursor.Next()
+			if isDirect {
+				c, nextOk = direct.Next()
+			} else {
+				c, nextOk = r.cursor.Next()
+			}
 		} else {
 			if mode == rbbiRunModeStart {
 				mode = rbbiRunModeRun
@@ -236,53 +379,79 @@ func (r *RBBI) Next() (position int, ok bool) {
 	// one. (This really indicates a defect in the break rules. They should
 	// always match at least one character.)
 	if result == initialPosition {
-		r.cursor.SetPosition(initialPosition)
-		c, ok = r.cursor.Next()
-		if !ok {
-			return -1, false
+		if err := r.setPosition(initialPosition); err != nil {
+			return -1, false, err
+		}
+
+		if _, ok := r.cursor.Next(); !ok {
+			return -1, false, cursorError("Cursor yielded no rune at position %v", initialPosition)
 		}
 
 		result = r.cursor.Position()
+		if result == initialPosition {
+			return -1, false, cursorError("Cursor did not advance from position %v", initialPosition)
+		}
 
 		r.ruleStatusIndex = 0
 	}
 
-	// Leave the iterator at our result position.
-	r.cursor.SetPosition(result)
-	return result, true
+	// Leave the iterator at our result position. A StringCursor can be moved
+	// directly, because the result is a position that it reported itself.
+	if isDirect {
+		direct.position = result
+	} else if err := r.setPosition(result); err != nil {
+		return -1, false, err
+	}
+
+	return result, true, nil
 }
 
 // Iterate backwards using the safe reverse rules. The logic of this function
 // is similar to Next(), but simpler because the safe table does not require as
 // many options.
-func (r *RBBI) safePrevious(fromPosition int) (position int, ok bool) {
-	r.cursor.SetPosition(fromPosition)
+func (r *RBBI) safePrevious(fromPosition int) (position int, ok bool, err error) {
+	if err := r.setPosition(fromPosition); err != nil {
+		return -1, false, err
+	}
+
+	// The tables must be consistent, so that the state machine does not need
+	// to check them
+	if err := r.rules.validate(); err != nil {
+		return -1, false, err
+	}
 
 	// Get the initial rune and bail out if we are already at the start of the
 	// string.
 	c, ok := r.cursor.Previous()
 	if !ok {
-		return -1, false
+		return -1, false, nil
 	}
 
 	// Set the initial state for the state machine
 	state := rbbiStateStart
-	row := r.data.reverseTable.rows[state]
+	step := 0
+	table := &r.data.reverseTable
+	rowLength := int(table.rowLength)
+	row := int(state) * rowLength
 
 	// Loop until we reach the start of the text or transition to state 0
 	for ok {
 		// Look up the current character's character category, which tells us
 		// which column in the state table to look at.
-		category := r.data.trie.fastGet(c)
-
-		if category >= r.data.categoryCount {
-			//U_ASSERT(category<fData->fHeader->fCatCount);
-			panic("Assertion error")
+		category, err := r.category(c)
+		if err != nil {
+			return -1, false, err
 		}
 
 		// State Transition - move machine to its next state
-		state = int32(row.nextStates[category])
-		row = r.data.reverseTable.rows[state]
+		previousState := state
+		state = int32(table.value(row + rbbiRowNextStates + int(category)))
+		row = int(state) * rowLength
+
+		if r.tracer != nil {
+			r.trace(TraceReverse, step, c, category, previousState, state, table.value(row+rbbiRowAccepting), table.value(row+rbbiRowLookahead))
+			step++
+		}
 
 		if state == rbbiStateStop {
 			// This is the normal exit from the lookup state machine.
@@ -295,9 +464,11 @@ func (r *RBBI) safePrevious(fromPosition int) (position int, ok bool) {
 
 	// The state machine is done. Check whether it found a match...
 	result := r.cursor.Position()
+	if result == fromPosition {
+		return -1, false, cursorError("Cursor did not retreat from position %v", fromPosition)
+	}
 
-	// TODO: When is ok false here?
-	return result, true
+	return result, true, nil
 }
 
 // Scan runes from the Cursor (in the backward direction) and stop at the first
@@ -311,96 +482,312 @@ func (r *RBBI) safePrevious(fromPosition int) (position int, ok bool) {
 // iteration sequence.
 //
 // On failure the Cursor is reset to the position it had at the start of the
-// Previous() call.
+// Previous() call. The value of ok is also false when iteration was stopped by
+// an error, which can be retrieved using Err().
 func (r *RBBI) Previous() (position int, ok bool) {
-	// Save cursor position
-	startPosition := r.cursor.Position()
-	backtraceStart := startPosition
-
-	// TODO: Take care of case when position = 0
-
-	// Loop until we've found a last breakpoint that is not equal to
-	// startPosition.
-	lastBreakpoint := -1
-	for lastBreakpoint == -1 {
-		// Scan backwards for a safe point
-		newStart, ok := r.safePrevious(backtraceStart)
+	return r.Preceding(r.cursor.Position())
+}
+
+// Find the first break following the provided Cursor position. Returns a
+// (position, ok) tuple. The value of ok is false when there is no break
+// following the position, i.e. when the position is at the end of the string.
+// In any other case, ok is set to true and the position of the break is
+// returned. The Cursor is also updated to this position, so iteration can
+// continue using Next() and Previous().
+//
+// Unlike Next(), the provided position does not need to be a break. The rule
+// status is updated to the status of the returned break.
+//
+// On failure the Cursor is reset to the provided position. The value of ok is
+// also false when iteration was stopped by an error, which can be retrieved
+// using Err(). A position that is refused by the Cursor, e.g. because it is
+// not at a rune boundary, results in a CursorError.
+func (r *RBBI) Following(position int) (int, bool) {
+	if r.err != nil {
+		return -1, false
+	}
+
+	return r.result(r.following(position))
+}
+
+// The implementation of Following(), which reports errors instead of
+// recording them.
+func (r *RBBI) following(position int) (int, bool, error) {
+	// Let the Cursor align the position to a rune boundary
+	if err := r.setPosition(position); err != nil {
+		return -1, false, err
+	}
+
+	position = r.cursor.Position()
+
+	_, boundary, ok, err := r.boundaryFromSafePoint(position)
+	if err != nil {
+		return -1, false, err
+	}
+
+	if ok && boundary > position {
+		return boundary, true, nil
+	}
+
+	// Scan forward until we have passed the provided position
+	for {
+		breakpoint, ok, err := r.handleNext()
+		if err != nil {
+			return -1, false, err
+		}
+
 		if !ok {
-			if backtraceStart == startPosition {
-				// Tried scanning before start of string when we were still at
-				// the start position. This means that startPosition was the
-				// beginning of the string. We can't go before this point, so
-				// return false. There is no need to set the cursor, because
-				// safePrevious did not change it.
-				return -1, false
-			}
+			return -1, false, r.setPosition(position)
+		}
+
+		if breakpoint > position {
+			return breakpoint, true, nil
+		}
+	}
+}
+
+// Find the last break preceding the provided Cursor position. Returns a
+// (position, ok) tuple. The value of ok is false when there is no break
+// preceding the position, i.e. when the position is at the start of the
+// string. In any other case, ok is set to true and the position of the break
+// is returned. The Cursor is also updated to this position, so iteration can
+// continue using Next() and Previous().
+//
+// Unlike Previous(), the provided position does not need to be a break. The
+// rule status is updated to the status of the returned break.
+//
+// On failure the Cursor is reset to the provided position. The value of ok is
+// also false when iteration was stopped by an error, which can be retrieved
+// using Err(). A position that is refused by the Cursor, e.g. because it is
+// not at a rune boundary, results in a CursorError.
+func (r *RBBI) Preceding(position int) (int, bool) {
+	if r.err != nil {
+		return -1, false
+	}
+
+	return r.result(r.preceding(position))
+}
+
+// The implementation of Preceding(), which reports errors instead of
+// recording them.
+func (r *RBBI) preceding(position int) (int, bool, error) {
+	// Let the Cursor align the position to a rune boundary
+	if err := r.setPosition(position); err != nil {
+		return -1, false, err
+	}
 
-			// Tried scanning before start of string, revert cursor to start
-			// position and return the start of string position.
-			if err := r.cursor.SetPosition(backtraceStart); err != nil {
-				// We were already able to go to the start position. This should be
-				// treated as an assertion error.
-				panic("Assertion error")
+	position = r.cursor.Position()
+	backtraceStart := position
+
+	for {
+		// Scan backwards for a safe point, and find the first reliable break
+		// following it.
+		safe, boundary, ok, err := r.boundaryFromSafePoint(backtraceStart)
+		if err != nil {
+			return -1, false, err
+		}
+
+		if !ok {
+			// We are at the start of the string, so there can't be any
+			// preceding break.
+			return -1, false, r.setPosition(position)
+		}
+
+		if boundary >= position {
+			// The first reliable break is not before the provided position,
+			// so we need to scan backwards further.
+			if safe == backtraceStart {
+				// The safe point is the start of the string
+				return -1, false, r.setPosition(position)
 			}
 
-			return backtraceStart, true
+			backtraceStart = safe
+			continue
 		}
 
-		// New safe point was not before start of string, save it so we can use
-		// it as the origin when scanning for the next safe point.
-		backtraceStart = newStart
+		// Find last breakpoint before the provided position, recording the
+		// rule status that came with it.
+		lastBreakpoint := boundary
+		lastStatusIndex := r.ruleStatusIndex
 
-		// Find last breakpoint before startPosition
 		for {
-			// Scan forward
-			breakpoint, ok := r.Next()
-			if !ok {
-				// TODO: Can this happen when startPosition is the end of
-				// the string? Need test case for this.
-				//
-				// Shouldn't happen because we know that startPosition is either at
-				// the end of the string (when ok should be false) or before it.
-				// Since we stop before we reach startPosition the value of ok
-				// should always be true.
-				panic("Assertion error")
+			breakpoint, ok, err := r.handleNext()
+			if err != nil {
+				return -1, false, err
 			}
 
-			// Break if we've reached startPosition
-			if r.cursor.Position() >= startPosition {
+			if !ok || breakpoint >= position {
 				break
 			}
 
-			// If we reach here we've found a breakpoint that is not the same
-			// as startPosition. Record it so we keep track of the last
-			// occurrence of this event.
 			lastBreakpoint = breakpoint
+			lastStatusIndex = r.ruleStatusIndex
+		}
+
+		// Set cursor to last breakpoint position (it is now at or beyond
+		// the provided position).
+		if err := r.setPosition(lastBreakpoint); err != nil {
+			return -1, false, err
+		}
+
+		r.ruleStatusIndex = lastStatusIndex
+
+		return lastBreakpoint, true, nil
+	}
+}
+
+// Return whether the provided Cursor position is a break. The start and end of
+// the string are always breaks. The Cursor is left at the provided position.
+// The value false is also returned when the iteration was stopped by an error,
+// which can be retrieved using Err(). A position that is refused by the
+// Cursor results in a CursorError, except for positions that are not at a rune
+// boundary, which are never breaks.
+func (r *RBBI) IsBoundary(position int) bool {
+	if r.err != nil {
+		return false
+	}
+
+	if err := r.cursor.SetPosition(position); err != nil {
+		// A position in the middle of a rune is never a break
+		if !errors.Is(err, ErrMisalignedPosition) {
+			r.err = &CursorError{Position: position, Err: err}
 		}
+
+		return false
 	}
 
-	// Set cursor to last breakpoint position (it is now at startPosition)
-	// and return true.
-	if err := r.cursor.SetPosition(lastBreakpoint); err != nil {
-		// We were already able to go to this position. This should be
-		// treated as an assertion error.
-		panic("Assertion error")
+	// The Cursor may have moved a position in the middle of a rune to a rune
+	// boundary, which leaves it at a position that is not a break
+	if r.cursor.Position() != position {
+		return false
 	}
 
-	// Return the breakpoint position
-	return lastBreakpoint, true
+	// Move back by one rune, the break following that rune is the provided
+	// position if it is a break.
+	if _, ok := r.cursor.Previous(); !ok {
+		r.ruleStatusIndex = 0
+		return true
+	}
+
+	breakpoint, ok := r.Following(r.cursor.Position())
+	if r.err != nil {
+		return false
+	}
+
+	if err := r.setPosition(position); err != nil {
+		r.err = err
+		return false
+	}
+
+	return ok && breakpoint == position
+}
+
+// Return the current position of the Cursor.
+func (r *RBBI) Current() int {
+	return r.cursor.Position()
+}
+
+// Return the status value of the rule that produced the most recent break.
+// When the rule has more than one status value the largest one is returned.
+// See the RuleStatus constants for the meaning of the values. Returns 0 when
+// the tables are corrupt.
+func (r *RBBI) RuleStatus() int {
+	if r.rules.validate() != nil {
+		return 0
+	}
+
+	count := r.data.ruleStatusTable[r.ruleStatusIndex]
+	return int(r.data.ruleStatusTable[r.ruleStatusIndex+count])
+}
+
+// Return all status values of the rule that produced the most recent break,
+// in ascending order. Returns nil when the tables are corrupt.
+func (r *RBBI) RuleStatuses() []int {
+	if r.rules.validate() != nil {
+		return nil
+	}
+
+	count := r.data.ruleStatusTable[r.ruleStatusIndex]
+	values := r.data.ruleStatusTable[r.ruleStatusIndex+1 : r.ruleStatusIndex+1+count]
+
+	statuses := make([]int, len(values))
+	for i, value := range values {
+		statuses[i] = int(value)
+	}
+
+	return statuses
+}
+
+// Find a safe point before the provided position using the safe reverse
+// rules, and return it along with the first reliable break at or after it. The
+// Cursor is left at the break, with the rule status of the break. The value of
+// ok is false when the position is the start of the string.
+func (r *RBBI) boundaryFromSafePoint(position int) (safe int, boundary int, ok bool, err error) {
+	safe, ok, err = r.safePrevious(position)
+	if err != nil {
+		return -1, -1, false, err
+	}
+
+	if !ok {
+		return -1, -1, false, r.setPosition(position)
+	}
+
+	// The start of the string is always a break
+	if _, ok := r.cursor.Previous(); !ok {
+		r.ruleStatusIndex = 0
+		return safe, safe, true, nil
+	}
+
+	if err := r.setPosition(safe); err != nil {
+		return -1, -1, false, err
+	}
+
+	boundary, ok, err = r.handleNext()
+	if err != nil {
+		return -1, -1, false, err
+	}
+
+	if !ok {
+		// Can't happen with a well-behaved Cursor, safePrevious moved back
+		// at least one rune
+		return -1, -1, false, cursorError("Cursor yielded no rune at position %v", safe)
+	}
+
+	// The safe reverse rules identify pairs of code points that are safe. If
+	// advancing from the safe point moved forward by only one code point, we
+	// need to advance one more time to ensure that the break is good,
+	// including a correct rule status value.
+	if _, ok := r.cursor.Previous(); !ok {
+		// Can't happen with a well-behaved Cursor, handleNext moved forward
+		// at least one rune
+		return -1, -1, false, cursorError("Cursor yielded no rune before position %v", boundary)
+	}
+
+	if r.cursor.Position() == safe {
+		if err := r.setPosition(boundary); err != nil {
+			return -1, -1, false, err
+		}
+
+		next, ok, err := r.handleNext()
+		if err != nil {
+			return -1, -1, false, err
+		}
+
+		if ok {
+			boundary = next
+		} else if err := r.setPosition(boundary); err != nil {
+			return -1, -1, false, err
+		}
+	} else if err := r.setPosition(boundary); err != nil {
+		return -1, -1, false, err
+	}
+
+	return safe, boundary, true, nil
 }
 
-// TODO: Next()
 // TODO: Next(delta)
-// TODO: Previous
 // TODO: First
 // TODO: Last
-// TODO: Following(offset)
-// TODO: Preceding(offset)
-// TODO: IsBoundary(offset)
-// TODO: Current
-
-// TODO: GetRuleStatus
-// TODO: GetRuleStatusVec (why?)
 
 // TODO: HandleNext with state machine algorithm
 // TODO: HandleSafePrevious with state machine
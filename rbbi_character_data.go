@@ -1,956 +0,0 @@
-// This file is auto-generated by maketable.py
-
-package rbbi
-
-// Lookup tables for character (grapheme cluster) breaks.
-var rbbiCharacterData rbbiData = rbbiData{
-	categoryCount: 21,
-
-	forwardTable: rbbiStateTable{
-		stateCount:           16,
-		rowLength:            24,
-		dictCategoriesStart:  21,
-		lookaheadResultsSize: 3,
-
-		lookaheadHardBreak: true,
-		bofRequired:        false,
-		valueWidth:         rbbiStateTableValueWidth8,
-
-		rows: []rbbiStateTableRow{
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 2, 2, 3, 4, 5, 4, 4, 6, 4, 7, 4, 8, 9,
-					10, 4, 9, 10, 11,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 4, 0, 4, 0, 0,
-					0, 4, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 4, 0, 5, 0, 0,
-					0, 12, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 4, 5, 4, 4, 6, 4, 7, 4, 8, 9,
-					10, 4, 9, 10, 11,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 7, 4, 0, 4, 0, 13, 0, 0,
-					0, 7, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 4, 0, 4, 8, 9,
-					0, 4, 9, 10, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 4, 0, 4, 0, 9,
-					10, 4, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 4, 0, 4, 0, 0,
-					10, 4, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 4, 0, 4, 0, 0,
-					0, 4, 0, 0, 14,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 5, 4, 4, 0, 4, 0, 4, 0, 0,
-					0, 4, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 13, 4, 0, 4, 7, 13, 0, 0,
-					0, 13, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 2,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 4, 0, 4, 0, 0,
-					0, 4, 0, 0, 15,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0,
-				},
-			},
-		},
-	},
-
-	reverseTable: rbbiStateTable{
-		stateCount:           16,
-		rowLength:            24,
-		dictCategoriesStart:  21,
-		lookaheadResultsSize: 3,
-
-		lookaheadHardBreak: true,
-		bofRequired:        false,
-		valueWidth:         rbbiStateTableValueWidth8,
-
-		rows: []rbbiStateTableRow{
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 2, 2, 3, 4, 5, 4, 4, 6, 4, 7, 4, 8, 9,
-					10, 4, 9, 10, 11,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 4, 0, 4, 0, 0,
-					0, 4, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 4, 0, 5, 0, 0,
-					0, 12, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 4, 5, 4, 4, 6, 4, 7, 4, 8, 9,
-					10, 4, 9, 10, 11,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 7, 4, 0, 4, 0, 13, 0, 0,
-					0, 7, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 4, 0, 4, 8, 9,
-					0, 4, 9, 10, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 4, 0, 4, 0, 9,
-					10, 4, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 4, 0, 4, 0, 0,
-					10, 4, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 4, 0, 4, 0, 0,
-					0, 4, 0, 0, 14,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 5, 4, 4, 0, 4, 0, 4, 0, 0,
-					0, 4, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 13, 4, 0, 4, 7, 13, 0, 0,
-					0, 13, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 2,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 4, 0, 4, 0, 0,
-					0, 4, 0, 0, 15,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0,
-				},
-			},
-		},
-	},
-
-	trie: ucpTrie{
-		trieType:           ucpTrieTypeFast,
-		valueWidth:         ucpTrieValueWidth8,
-		dataLength:         7142,
-		index3NullOffset:   203,
-		dataNullOffset:     32,
-		highStart:          921600,
-		shifted12HighStart: 225,
-		nullValueOffset:    32,
-
-		index: []uint16{
-			0, 64, 127, 32, 32, 32, 32, 32, 32, 32, 32, 32, 191, 240, 32, 32,
-			32, 32, 301, 32, 32, 32, 348, 410, 474, 527, 32, 576, 625, 678, 704, 753,
-			815, 861, 909, 963, 1027, 1090, 1154, 1217, 1280, 1343, 1406, 1469, 1533, 1597, 1659, 1721,
-			1785, 1848, 1911, 1975, 2039, 2102, 2165, 2219, 2271, 2330, 2345, 2406, 2446, 2510, 2574, 2635,
-			2654, 2717, 2779, 32, 2843, 2875, 2907, 2947, 32, 32, 32, 32, 32, 3011, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 3057, 3110, 3162, 3224,
-			3277, 32, 3336, 32, 3378, 32, 32, 32, 3438, 3481, 3545, 674, 3609, 3671, 3735, 3781,
-			3833, 32, 32, 3889, 32, 32, 32, 191, 32, 32, 32, 32, 32, 32, 32, 32,
-			3947, 3998, 32, 4046, 4095, 32, 4153, 32, 32, 32, 32, 32, 4196, 32, 4228, 4277,
-			32, 32, 32, 4339, 32, 32, 4361, 4415, 4479, 4499, 4557, 4499, 4615, 4675, 4718, 32,
-			32, 32, 32, 32, 4351, 32, 32, 32, 32, 32, 32, 32, 4776, 4824, 32, 32,
-			32, 32, 32, 4846, 32, 4896, 32, 333, 32, 32, 32, 32, 32, 32, 32, 32,
-			4917, 32, 4979, 32, 32, 32, 32, 32, 32, 32, 5020, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 5046, 5108, 2422, 32, 32, 32, 32,
-			5170, 32, 5234, 5294, 5358, 5415, 5479, 5542, 5580, 5641, 5702, 5766, 32, 32, 32, 5821,
-			5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893,
-			5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909,
-			5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897,
-			5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885,
-			5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901,
-			5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889,
-			5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905,
-			5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893,
-			5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909,
-			5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897,
-			5905, 5885, 5893, 5901, 5909, 5889, 5897, 5905, 5885, 5893, 5901, 5909, 5889, 5897, 5961, 6018,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 6078, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 6142, 32, 32, 64, 32, 32, 3130, 6190,
-			1989, 2016, 2040, 2071, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103,
-			2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103,
-			2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103, 2103,
-			2103, 2103, 2103, 2103, 2135, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 562, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 287, 32, 32, 32, 32, 32, 32, 32, 32, 6250, 32, 32, 32,
-			32, 32, 32, 32, 32, 6265, 32, 32, 6281, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 2465, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 6292, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 2459, 32, 32, 32, 32, 32, 32, 32, 32, 32, 185, 287,
-			32, 32, 6294, 32, 32, 32, 32, 32, 32, 32, 6310, 32, 32, 734, 6326, 32,
-			32, 6342, 1028, 32, 32, 6358, 6372, 32, 32, 32, 285, 32, 6386, 6399, 2264, 32,
-			32, 572, 1028, 32, 32, 6412, 6427, 32, 32, 32, 6443, 6458, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 727, 6474, 32, 2039, 32, 32, 6485, 6499, 1654, 6513, 283,
-			32, 32, 32, 32, 32, 32, 32, 32, 6410, 6529, 561, 32, 32, 32, 32, 32,
-			6545, 6560, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 727,
-			6576, 287, 1379, 32, 32, 32, 6592, 310, 32, 32, 32, 32, 32, 6608, 6624, 32,
-			32, 32, 32, 32, 729, 6638, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 6443, 6654, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 6670, 6686, 32, 32, 32, 32, 32, 32, 32, 32, 6701, 6717,
-			32, 6732, 32, 32, 6745, 568, 6760, 32, 32, 6772, 6782, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 2254, 6798, 32, 32, 32, 32, 32, 6140, 6814, 6829, 32, 32, 32, 32, 32,
-			32, 32, 6844, 6859, 32, 32, 32, 6867, 6883, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 2036, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 23, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 283, 32, 32, 32, 281, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 727,
-			6898, 6899, 6899, 6907, 750, 32, 32, 32, 32, 1657, 1156, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 6923, 28, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 2599, 2599, 2621, 2599, 746,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 6938, 6952, 6965, 32, 6977, 32, 32, 32, 32, 32,
-			884, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 2599, 2599, 2599, 6993,
-			2599, 2599, 2622, 1656, 1657, 731, 2598, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 7009, 7017, 7031, 32,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 281,
-			32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 561, 32, 32, 32,
-			179, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 281, 32, 32, 32, 32, 32, 32, 7043, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499,
-			4499, 4499, 4499, 4499, 4499, 4160, 32, 4114, 32, 32, 32, 4161, 7059, 4115, 7072, 4160,
-			4499, 4499, 4499, 7088, 7094, 4498, 3997, 4114, 7110, 4309, 4499, 4499, 4499, 4499, 4499, 4499,
-			4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499,
-			4499, 4499, 4499, 4499, 7122, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499,
-			4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4549, 4312, 4499, 4499, 4499, 4499, 4499, 4499,
-			4499, 4499, 4499, 4499, 4499, 32, 32, 32, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499,
-			32, 32, 32, 32, 32, 32, 32, 4569, 32, 32, 32, 32, 32, 4313, 4499, 4499,
-			4161, 32, 32, 32, 4310, 4163, 32, 32, 4310, 32, 4159, 4499, 4499, 4499, 4499, 4499,
-			4161, 4499, 4499, 4487, 4479, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499,
-			4499, 4499, 4499, 4499, 4499, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-			32, 32, 32, 32, 32, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499,
-			4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499, 4499,
-			4499, 4499, 4499, 4499, 4499, 4549, 14, 14, 2599, 2599, 2599, 2599, 2599, 2599, 14, 14,
-			14, 14, 14, 14, 14, 14, 2599, 2599, 2599, 2599, 2599, 2599, 2599, 2599, 2599, 2599,
-			2599, 2599, 2599, 2599, 2599, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 1077, 1109, 203, 203, 203, 1141, 1156, 1178, 1210, 1240, 1269,
-			1299, 1329, 1361, 1391, 1417, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 1446,
-			203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203,
-			203, 203, 203, 203, 203, 1463, 203, 1483, 203, 203, 203, 203, 203, 203, 203, 203,
-			203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203,
-			203, 203, 203, 203, 203, 203, 1515, 203, 203, 203, 203, 203, 203, 203, 1531, 1552,
-			1580, 203, 203, 203, 1612, 203, 203, 1644, 1666, 203, 203, 1685, 203, 203, 203, 1717,
-			1749, 1781, 1808, 1840, 1861, 1893, 1894, 203, 203, 203, 203, 203, 203, 203, 203, 203,
-			203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203,
-			203, 203, 203, 203, 203, 203, 203, 1926, 1957, 1957, 1957, 1957, 1957, 1957, 1957,
-		},
-
-		data8: []uint8{
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 3, 5, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 7, 6, 6, 6, 3, 7, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 9,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			8, 8, 8, 8, 8, 9, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 6, 8, 8, 6, 8, 8,
-			6, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 10, 10, 10, 10, 10, 10,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 6, 3, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 8, 8, 8, 8, 8, 8, 8, 10, 6, 8,
-			8, 8, 8, 8, 8, 6, 6, 8, 8, 6, 8, 8, 8, 8, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			10, 6, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
-			9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 8, 8, 8, 8, 6, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 6, 8, 8, 8, 6, 8, 8, 8, 8, 8, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 8, 8, 8, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 10, 10, 6,
-			6, 6, 6, 6, 6, 8, 8, 8, 8, 8, 8, 8, 8, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 10, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 9, 9, 9, 11, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 9, 11, 8,
-			6, 11, 11, 9, 9, 9, 9, 9, 9, 9, 9, 11, 11, 11, 11, 13,
-			11, 11, 6, 8, 8, 8, 8, 9, 9, 9, 12, 12, 12, 12, 12, 12,
-			12, 12, 6, 6, 9, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 12, 12, 12, 12, 12, 12,
-			12, 12, 6, 9, 11, 11, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 6, 12, 12, 12, 12,
-			12, 12, 12, 6, 12, 6, 6, 6, 12, 12, 12, 12, 6, 6, 8, 6,
-			9, 11, 9, 9, 9, 9, 6, 6, 11, 11, 6, 6, 11, 11, 13, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 9, 6, 6, 6, 6, 12, 12, 6,
-			12, 6, 6, 9, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 12, 12, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8,
-			6, 9, 9, 11, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 6, 11, 11,
-			9, 9, 6, 6, 6, 6, 9, 9, 6, 6, 9, 9, 8, 6, 6, 6,
-			9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9,
-			9, 6, 6, 6, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9,
-			9, 11, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 6, 12, 12, 12, 12, 12, 12, 12, 6,
-			12, 12, 6, 12, 12, 12, 12, 12, 6, 6, 8, 6, 11, 11, 9, 9,
-			9, 9, 9, 6, 9, 9, 11, 6, 11, 11, 13, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9,
-			9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 12, 9, 9, 9, 9, 9, 9, 6, 9, 11,
-			11, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 6, 12, 12, 12, 12, 12, 12, 12, 6, 12,
-			12, 6, 12, 12, 12, 12, 12, 6, 6, 8, 6, 9, 9, 11, 9, 9,
-			9, 9, 6, 6, 11, 11, 6, 6, 11, 11, 13, 6, 6, 6, 6, 6,
-			6, 6, 9, 9, 9, 6, 6, 6, 6, 12, 12, 6, 12, 6, 6, 9,
-			9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 12, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 11, 11, 6, 6, 6, 11,
-			11, 11, 6, 11, 11, 11, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 11, 11, 11, 9, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 6, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 6, 6, 8, 6, 9, 9, 11, 11, 11, 11, 6, 9, 9,
-			9, 6, 9, 9, 9, 13, 6, 6, 6, 6, 6, 6, 6, 8, 8, 6,
-			12, 12, 12, 6, 6, 6, 6, 6, 6, 6, 9, 9, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 9, 11, 11, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 8, 6, 11, 9, 11, 11, 9, 11, 11, 6, 9, 11, 11,
-			6, 11, 11, 9, 8, 6, 6, 6, 6, 6, 6, 6, 9, 9, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 9, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 9, 9, 11, 11, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 8, 8, 6, 9, 11, 9, 9, 9, 9, 6, 11, 11, 11, 6,
-			11, 11, 11, 13, 10, 6, 6, 6, 6, 6, 6, 6, 6, 9, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 9, 9, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 9, 11, 11, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 8, 6, 6, 6, 6, 9, 11, 11, 9, 9, 9,
-			6, 9, 6, 11, 11, 11, 11, 11, 11, 11, 9, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 11, 11, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			9, 6, 11, 9, 9, 9, 9, 8, 8, 8, 6, 6, 6, 6, 6, 6,
-			6, 9, 8, 8, 8, 8, 9, 9, 9, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 6, 11, 9, 9, 9,
-			9, 8, 8, 8, 9, 9, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8,
-			8, 8, 9, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 8, 8, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 8, 6, 8, 6, 8, 6, 6, 6, 6, 11, 11, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8,
-			8, 9, 8, 9, 9, 9, 9, 9, 8, 8, 8, 8, 9, 11, 8, 9,
-			8, 8, 8, 6, 8, 8, 6, 6, 6, 6, 6, 9, 9, 9, 9, 9,
-			9, 9, 9, 9, 9, 9, 6, 9, 9, 9, 9, 9, 9, 9, 9, 9,
-			9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
-			9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 6, 6, 6, 6, 6,
-			6, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 9, 9, 9, 11,
-			9, 9, 9, 9, 9, 8, 6, 8, 8, 11, 11, 9, 9, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 11, 11, 9, 9, 6, 6, 6, 6, 9, 9, 9, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 9,
-			9, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 6, 11,
-			9, 9, 6, 6, 6, 6, 6, 6, 8, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 9, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			8, 8, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 9, 9, 8, 11, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 9, 9, 11, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 9, 9, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 9, 9, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 9,
-			11, 9, 9, 9, 9, 9, 9, 9, 11, 11, 11, 11, 11, 11, 9, 11,
-			11, 9, 9, 9, 9, 9, 9, 9, 9, 9, 8, 9, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 9, 9, 9, 3, 9, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 9, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 9, 9, 9, 11, 11, 11, 11, 9, 9, 11, 11, 11, 6, 6,
-			6, 6, 11, 11, 9, 11, 11, 11, 11, 11, 11, 8, 8, 8, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 8, 8, 11, 11, 9, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 11, 9,
-			11, 9, 9, 9, 9, 9, 9, 9, 6, 8, 6, 9, 6, 6, 9, 9,
-			9, 9, 9, 9, 9, 9, 11, 11, 11, 11, 11, 11, 9, 9, 8, 8,
-			8, 8, 8, 8, 8, 8, 6, 6, 8, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 9, 8, 9, 9, 9, 9, 11, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 9, 9,
-			9, 9, 9, 9, 11, 9, 11, 11, 11, 9, 11, 11, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 8, 8, 8, 8, 8, 8, 8, 8, 8, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 9, 9, 11, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 11, 9, 9, 9, 9, 11, 11, 9,
-			9, 11, 8, 9, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 11, 9, 9, 11,
-			11, 11, 9, 11, 9, 9, 9, 11, 11, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 11, 11, 11,
-			11, 11, 11, 11, 11, 9, 9, 9, 9, 9, 9, 9, 9, 11, 11, 9,
-			8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 8, 8, 8, 6, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 11, 8, 8, 8, 8, 8, 8, 8, 6, 6, 6, 6, 8, 6,
-			6, 6, 6, 6, 6, 8, 6, 6, 11, 8, 8, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 3, 9, 17, 3, 3, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 3, 3, 3, 3, 3, 3, 3, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 7, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 9, 9, 9, 9, 8,
-			9, 9, 9, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 7, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7, 7, 7,
-			7, 7, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 7, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7, 7,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7, 7,
-			7, 7, 7, 7, 7, 7, 7, 7, 7, 6, 6, 6, 6, 7, 7, 7,
-			6, 6, 6, 6, 6, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 7, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7, 7, 7, 7, 6, 7,
-			7, 7, 7, 7, 7, 6, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
-			7, 7, 6, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
-			7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
-			7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
-			7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
-			7, 7, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7, 7, 7,
-			7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
-			7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
-			7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 6, 6, 7,
-			7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 6, 7, 6, 7, 6, 6,
-			6, 6, 6, 6, 7, 6, 6, 6, 7, 6, 6, 6, 6, 6, 6, 7,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7, 7, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 7, 6, 6, 7, 6, 6, 6, 6, 7,
-			6, 7, 6, 6, 6, 6, 7, 7, 7, 6, 7, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 7, 7, 7, 7, 7, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 7, 7, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 7, 7, 7,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 7, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 7, 6, 6, 6, 6, 7, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8, 8,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8,
-			8, 8, 8, 8, 8, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 7, 6, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 8, 9, 9, 9, 6, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 8, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 9, 6, 6, 6, 8, 6, 6, 6, 6, 9, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 11, 11, 9, 9, 11, 6, 6, 6, 6, 8, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 11, 11, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 8, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 9, 9, 9, 9, 9, 8, 8, 8, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 9,
-			9, 9, 9, 9, 9, 9, 9, 9, 9, 11, 11, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 6, 6, 6, 9, 9, 9, 11, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 11, 11, 9, 9, 9,
-			9, 11, 11, 9, 9, 11, 11, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 9, 9, 9, 9, 9, 9, 11, 11, 9, 9, 11,
-			11, 9, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 6, 6, 6,
-			6, 6, 6, 6, 6, 9, 11, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 8, 6, 8, 8, 8, 6, 6, 8, 8, 6,
-			6, 6, 6, 6, 8, 8, 6, 8, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 11, 9, 9, 11, 11, 6, 6, 6, 6, 6, 11, 8, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			11, 11, 9, 11, 11, 9, 11, 11, 6, 11, 8, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 18, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 19, 19, 18, 19, 19, 19, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-			19, 19, 19, 19, 19, 18, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-			19, 18, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 6, 6, 6, 6, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 9,
-			9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 6, 6, 6, 6, 6, 6,
-			8, 8, 8, 8, 8, 6, 6, 6, 6, 6, 9, 9, 9, 6, 9, 9,
-			6, 6, 6, 6, 6, 9, 8, 9, 8, 6, 6, 6, 6, 6, 6, 6,
-			6, 8, 8, 8, 6, 6, 6, 6, 8, 8, 8, 8, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 11, 9, 11, 6, 6, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 9, 9, 9, 9, 9, 9, 8, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 8, 6, 6, 9, 9, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 8, 11, 11, 11, 9, 9, 9, 9, 11, 11, 8,
-			8, 6, 6, 10, 6, 6, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 10, 6, 6, 6, 6, 6, 6, 6, 9, 9, 9, 9, 9, 11, 9,
-			9, 9, 8, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 11,
-			11, 11, 9, 9, 9, 9, 9, 9, 9, 9, 9, 11, 6, 10, 10, 6,
-			6, 6, 6, 6, 9, 8, 9, 9, 6, 11, 9, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 11, 11, 11, 9, 9, 11, 11, 9, 11,
-			8, 9, 6, 6, 6, 6, 6, 6, 9, 6, 11, 11, 11, 9, 9, 9,
-			9, 9, 9, 8, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			8, 8, 6, 9, 11, 11, 11, 11, 6, 6, 11, 11, 6, 6, 11, 11,
-			11, 6, 6, 11, 11, 6, 6, 8, 8, 8, 8, 8, 8, 8, 6, 6,
-			6, 11, 11, 8, 9, 9, 11, 8, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 9, 11, 11, 9, 9, 9, 9, 9, 9, 11, 9, 11, 11, 9, 11,
-			9, 11, 8, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			11, 11, 9, 9, 9, 9, 6, 6, 11, 11, 11, 11, 9, 9, 11, 8,
-			11, 11, 11, 9, 9, 9, 9, 9, 9, 9, 9, 11, 11, 9, 11, 8,
-			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 11, 9, 11, 11,
-			9, 9, 9, 9, 9, 9, 11, 8, 6, 6, 6, 6, 6, 6, 6, 6,
-			9, 9, 9, 9, 11, 9, 9, 9, 9, 8, 6, 6, 6, 6, 9, 9,
-			9, 9, 9, 9, 9, 9, 11, 8, 8, 6, 6, 6, 6, 6, 9, 11,
-			11, 11, 11, 11, 6, 11, 11, 6, 6, 9, 9, 11, 8, 10, 11, 10,
-			11, 8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 11, 11,
-			11, 9, 9, 9, 9, 6, 6, 9, 9, 11, 11, 11, 11, 8, 6, 6,
-			6, 11, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 9, 9,
-			9, 9, 9, 9, 9, 9, 9, 6, 6, 6, 6, 6, 9, 8, 9, 9,
-			9, 9, 11, 10, 9, 9, 9, 9, 6, 9, 9, 9, 9, 9, 9, 11,
-			11, 9, 9, 9, 6, 6, 6, 6, 10, 10, 10, 10, 10, 10, 9, 9,
-			9, 9, 9, 9, 9, 11, 9, 8, 6, 6, 6, 6, 6, 6, 9, 9,
-			9, 9, 9, 9, 9, 6, 9, 9, 9, 9, 9, 9, 11, 8, 9, 9,
-			9, 9, 9, 9, 9, 9, 6, 11, 9, 9, 9, 9, 9, 9, 11, 9,
-			9, 11, 9, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 9, 9, 9,
-			9, 9, 9, 6, 6, 6, 9, 6, 9, 9, 6, 9, 9, 8, 9, 8,
-			8, 10, 9, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 11, 11, 11,
-			11, 11, 6, 9, 9, 6, 11, 11, 9, 11, 8, 6, 6, 6, 6, 6,
-			6, 6, 6, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 6, 6, 6, 6, 6, 6, 6, 9, 6, 6, 6, 6, 6,
-			6, 6, 6, 6, 6, 6, 6, 6, 9, 8, 6, 6, 6, 6, 6, 8,
-			11, 8, 8, 8, 6, 6, 6, 11, 8, 8, 8, 3, 3, 3, 3, 3,
-			3, 3, 3, 8, 8, 8, 8, 8, 6, 6, 8, 8, 8, 8, 8, 8,
-			8, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 8, 8, 8, 8, 6,
-			6, 9, 9, 9, 9, 9, 9, 9, 6, 6, 6, 6, 9, 9, 9, 9,
-			9, 8, 8, 8, 8, 8, 8, 8, 6, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 6, 6, 8, 8, 8, 8, 8, 6, 8, 8, 6, 8, 8, 8,
-			8, 8, 6, 6, 6, 6, 6, 8, 8, 8, 8, 8, 8, 8, 6, 6,
-			6, 6, 6, 7, 7, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-			6, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 6, 6, 6, 6, 6,
-			7, 7, 7, 7, 7, 7, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-			20, 20, 20, 20, 20, 20, 6, 6, 7, 7, 7, 7, 7, 7, 7, 7,
-			7, 6, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 9, 9, 9,
-			9, 9, 6, 6, 6, 0,
-		},
-
-		nullValue: 6,
-	},
-}
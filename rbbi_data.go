@@ -1,13 +1,14 @@
 package rbbi
 
-type rbbiStateTableRow struct {
-	accepting uint8
-	lookahead uint8
-	tagIndex  uint8
-
-	// Note: length of nextStates is equal to RRBIData.categoryCount
-	nextStates []uint8
-}
+// Offsets of the fields of a row in a state table. A row consists of these
+// fields followed by the next state for each character category, like
+// RBBIStateTableRow in ICU.
+const (
+	rbbiRowAccepting  = 0
+	rbbiRowLookahead  = 1
+	rbbiRowTagIndex   = 2
+	rbbiRowNextStates = 3
+)
 
 type rbbiStateTableValueWidth uint8
 
@@ -17,7 +18,7 @@ const (
 )
 
 type rbbiStateTable struct {
-	stateCount           uint32 // TODO: same as len(rows)?
+	stateCount           uint32 // Number of rows in the table
 	rowLength            uint32
 	dictCategoriesStart  uint32
 	lookaheadResultsSize uint32
@@ -27,8 +28,31 @@ type rbbiStateTable struct {
 	bofRequired        bool                     // 0x2
 	valueWidth         rbbiStateTableValueWidth // 0x4 (to use 8 bits)
 
-	// TODO: Tables can be either 8 or 16 bits
-	rows []rbbiStateTableRow
+	// The rows of the state table stored back to back, each consisting of
+	// rowLength entries. The next state for a category is found at
+	// state*rowLength+rbbiRowNextStates+category. Depending on the value
+	// width either table8 or table16 is used, the latter being required for
+	// tables with more than 255 states.
+	table8  []uint8
+	table16 []uint16
+}
+
+// Return the entry at the provided index of the state table.
+func (t *rbbiStateTable) value(index int) int {
+	if t.valueWidth == rbbiStateTableValueWidth16 {
+		return int(t.table16[index])
+	}
+
+	return int(t.table8[index])
+}
+
+// Return the number of entries of the state table.
+func (t *rbbiStateTable) length() int {
+	if t.valueWidth == rbbiStateTableValueWidth16 {
+		return len(t.table16)
+	}
+
+	return len(t.table8)
 }
 
 type rbbiData struct {
@@ -39,8 +63,108 @@ type rbbiData struct {
 
 	categoryCount uint32
 
+	// The names of the categories, such as ALetter or Numeric, following the
+	// Unicode property values and the variable names of the ICU rules
+	categoryNames []string
+
+	// Rule status values, stored as groups consisting of a count followed by
+	// that many values. The tagIndex of a state table row is the index of the
+	// start of a group.
+	ruleStatusTable []int32
+
 	// TODO: Rule source?
-	// TODO: Status table?
 
 	// TODO: More stuff from the header
 }
+
+// Check the consistency of the tables, so that the state machines can look up
+// transitions, lookahead results and rule status values without checking
+// every access. Returns an error wrapping ErrCorruptTable when the tables are
+// inconsistent. The values of the trie are not checked, because the category
+// of every rune is checked when it is looked up.
+func (d *rbbiData) validate() error {
+	if d.categoryCount < 3 {
+		return corruptTableError("category count %v is too small", d.categoryCount)
+	}
+
+	// Categories are stored as 16-bit values, also by the Latin-1 category
+	// table of the rules
+	if d.categoryCount > 0xffff {
+		return corruptTableError("category count %v is too large", d.categoryCount)
+	}
+
+	if d.categoryNames != nil && len(d.categoryNames) != int(d.categoryCount) {
+		return corruptTableError("%v category names for %v categories", len(d.categoryNames), d.categoryCount)
+	}
+
+	// Check that every group of the rule status table is complete
+	for index := 0; index < len(d.ruleStatusTable); index += int(d.ruleStatusTable[index]) + 1 {
+		if !d.validRuleStatusIndex(index) {
+			return corruptTableError("rule status group %v is incomplete", index)
+		}
+	}
+
+	if err := d.forwardTable.validate(d); err != nil {
+		return err
+	}
+
+	return d.reverseTable.validate(d)
+}
+
+// Return whether an index of the rule status table is the start of a group.
+func (d *rbbiData) validRuleStatusIndex(index int) bool {
+	return index < len(d.ruleStatusTable) &&
+		d.ruleStatusTable[index] >= 1 &&
+		index+int(d.ruleStatusTable[index]) < len(d.ruleStatusTable)
+}
+
+// Check the consistency of a state table. Returns an error wrapping
+// ErrCorruptTable when the table is inconsistent.
+func (t *rbbiStateTable) validate(d *rbbiData) error {
+	if t.rowLength != rbbiRowNextStates+d.categoryCount {
+		return corruptTableError("row length %v does not match category count %v", t.rowLength, d.categoryCount)
+	}
+
+	if t.valueWidth != rbbiStateTableValueWidth8 && t.valueWidth != rbbiStateTableValueWidth16 {
+		return corruptTableError("invalid state table value width %v", t.valueWidth)
+	}
+
+	if t.stateCount <= uint32(rbbiStateStart) || uint64(t.length()) != uint64(t.stateCount)*uint64(t.rowLength) {
+		return corruptTableError("state table size %v does not match state count %v", t.length(), t.stateCount)
+	}
+
+	// Lookahead results are numbered using the values of the table, so
+	// there can't be more than the largest value allows
+	maxValue := uint32(0xff)
+	if t.valueWidth == rbbiStateTableValueWidth16 {
+		maxValue = 0xffff
+	}
+
+	if t.lookaheadResultsSize > maxValue+1 {
+		return corruptTableError("lookahead results size %v is too large", t.lookaheadResultsSize)
+	}
+
+	for state := 0; state < int(t.stateCount); state++ {
+		row := state * int(t.rowLength)
+
+		if accepting := t.value(row + rbbiRowAccepting); accepting > int(rbbiAcceptingUnconditional) && uint32(accepting) >= t.lookaheadResultsSize {
+			return corruptTableError("lookahead result %v of state %v is out of range", accepting, state)
+		}
+
+		if lookahead := t.value(row + rbbiRowLookahead); lookahead != 0 && (lookahead <= int(rbbiAcceptingUnconditional) || uint32(lookahead) >= t.lookaheadResultsSize) {
+			return corruptTableError("lookahead rule %v of state %v is out of range", lookahead, state)
+		}
+
+		if tagIndex := t.value(row + rbbiRowTagIndex); !d.validRuleStatusIndex(tagIndex) {
+			return corruptTableError("rule status index %v of state %v is out of range", tagIndex, state)
+		}
+
+		for i := row + rbbiRowNextStates; i < row+int(t.rowLength); i++ {
+			if next := t.value(i); uint32(next) >= t.stateCount {
+				return corruptTableError("next state %v of state %v is out of range", next, state)
+			}
+		}
+	}
+
+	return nil
+}
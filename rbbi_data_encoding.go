@@ -0,0 +1,229 @@
+package rbbi
+
+import (
+	"hash/adler32"
+)
+
+// The tables of a kind of break are embedded in the binary in a compact
+// encoding, which is decoded when the rules are first used. All integers are
+// little endian. The encoding consists of a header:
+//
+//	magic              4 bytes  "RBBI"
+//	version            uint16   rbbiDataVersion
+//	checksum           uint32   Adler-32 of the rest of the encoding
+//
+// Adler-32 detects any modified byte, and unlike hash/crc32 it doesn't link
+// lookup tables and their initialization into every program.
+//
+// Followed by the sections of rbbiData, where every array is preceded by its
+// number of entries as a uint32:
+//
+//	categoryCount      uint32
+//	categoryNames      array of strings, each a uint8 length and the bytes
+//	forwardTable       state table
+//	reverseTable       state table
+//	ruleStatusTable    array of int32
+//	trie               trie
+//
+// A state table is encoded as:
+//
+//	stateCount, rowLength, dictCategoriesStart, lookaheadResultsSize  uint32
+//	flags              uint8    0x1 lookaheadHardBreak, 0x2 bofRequired
+//	valueWidth         uint8    rbbiStateTableValueWidth
+//	table              array of uint8 or uint16, depending on valueWidth
+//
+// A trie is encoded as:
+//
+//	trieType, valueWidth                                        uint8
+//	dataLength                                                  int32
+//	index3NullOffset, dataNullOffset                            uint16
+//	highStart                                                   int32
+//	shifted12HighStart, nullValueOffset, nullValue              uint32
+//	index              array of uint16
+//	data               array of uint8, uint16 or uint32, depending on valueWidth
+const (
+	rbbiDataMagic   = "RBBI"
+	rbbiDataVersion = 1
+
+	// The length of the magic, version and checksum
+	rbbiDataHeaderLength = 10
+
+	rbbiStateTableFlagLookaheadHardBreak = 0x1
+	rbbiStateTableFlagBOFRequired        = 0x2
+)
+
+// Decode tables in the format described above. Returns an error wrapping
+// ErrCorruptTable when the encoding is truncated, has an unknown version or a
+// wrong checksum. The consistency of the decoded tables is not checked, which
+// is left to rbbiData.validate().
+func decodeRBBIData(encoded string) (*rbbiData, error) {
+	if len(encoded) < rbbiDataHeaderLength || encoded[:len(rbbiDataMagic)] != rbbiDataMagic {
+		return nil, corruptTableError("the encoded tables have no header")
+	}
+
+	dec := rbbiDataDecoder{encoded: encoded[len(rbbiDataMagic):]}
+	if version := dec.uint16(); version != rbbiDataVersion {
+		return nil, corruptTableError("unsupported version %v of the encoded tables", version)
+	}
+
+	if checksum := dec.uint32(); checksum != adler32.Checksum([]byte(dec.encoded)) {
+		return nil, corruptTableError("checksum %08x of the encoded tables does not match", checksum)
+	}
+
+	d := &rbbiData{}
+	d.categoryCount = dec.uint32()
+
+	if count := dec.count(1); count > 0 {
+		d.categoryNames = make([]string, count)
+		for i := range d.categoryNames {
+			d.categoryNames[i] = dec.take(int(dec.uint8()))
+		}
+	}
+
+	dec.stateTable(&d.forwardTable)
+	dec.stateTable(&d.reverseTable)
+
+	d.ruleStatusTable = make([]int32, dec.count(4))
+	for i := range d.ruleStatusTable {
+		d.ruleStatusTable[i] = int32(dec.uint32())
+	}
+
+	t := &d.trie
+	t.trieType = ucpTrieType(dec.uint8())
+	t.valueWidth = ucpTrieValueWidth(dec.uint8())
+	t.dataLength = int32(dec.uint32())
+	t.index3NullOffset = dec.uint16()
+	t.dataNullOffset = dec.uint16()
+	t.highStart = int32(dec.uint32())
+	t.shifted12HighStart = dec.uint32()
+	t.nullValueOffset = dec.uint32()
+	t.nullValue = dec.uint32()
+
+	t.index = make([]uint16, dec.count(2))
+	for i := range t.index {
+		t.index[i] = dec.uint16()
+	}
+
+	switch t.valueWidth {
+	case ucpTrieValueWidth8:
+		t.data8 = []uint8(dec.take(dec.count(1)))
+	case ucpTrieValueWidth16:
+		t.data16 = make([]uint16, dec.count(2))
+		for i := range t.data16 {
+			t.data16[i] = dec.uint16()
+		}
+	case ucpTrieValueWidth32:
+		t.data32 = make([]uint32, dec.count(4))
+		for i := range t.data32 {
+			t.data32[i] = dec.uint32()
+		}
+	default:
+		return nil, corruptTableError("unknown value width %v of the trie", t.valueWidth)
+	}
+
+	if dec.err != nil {
+		return nil, dec.err
+	}
+
+	if len(dec.encoded) != 0 {
+		return nil, corruptTableError("%v bytes follow the encoded tables", len(dec.encoded))
+	}
+
+	return d, nil
+}
+
+// A decoder that reads the values of the encoding in order. Reading past the
+// end records an error and returns zero values, so that the error only has to
+// be checked at the end.
+type rbbiDataDecoder struct {
+	encoded string
+	err     error
+}
+
+// Return the next n bytes of the encoding.
+func (dec *rbbiDataDecoder) take(n int) string {
+	if n > len(dec.encoded) {
+		if dec.err == nil {
+			dec.err = corruptTableError("the encoded tables are truncated")
+		}
+
+		dec.encoded = ""
+		return ""
+	}
+
+	result := dec.encoded[:n]
+	dec.encoded = dec.encoded[n:]
+
+	return result
+}
+
+// Return the next uint8 of the encoding.
+func (dec *rbbiDataDecoder) uint8() uint8 {
+	if b := dec.take(1); len(b) == 1 {
+		return b[0]
+	}
+
+	return 0
+}
+
+// Return the next little endian uint16 of the encoding.
+func (dec *rbbiDataDecoder) uint16() uint16 {
+	if b := dec.take(2); len(b) == 2 {
+		return uint16(b[0]) | uint16(b[1])<<8
+	}
+
+	return 0
+}
+
+// Return the next little endian uint32 of the encoding.
+func (dec *rbbiDataDecoder) uint32() uint32 {
+	if b := dec.take(4); len(b) == 4 {
+		return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24
+	}
+
+	return 0
+}
+
+// Return the number of entries of an array with entries of the provided size,
+// which is limited to what remains of the encoding so that a corrupt count
+// can't cause a huge allocation.
+func (dec *rbbiDataDecoder) count(size int) int {
+	count := uint64(dec.uint32())
+	if count*uint64(size) > uint64(len(dec.encoded)) {
+		if dec.err == nil {
+			dec.err = corruptTableError("array of %v entries exceeds the encoded tables", count)
+		}
+
+		dec.encoded = ""
+		return 0
+	}
+
+	return int(count)
+}
+
+// Decode the next state table of the encoding into the provided table.
+func (dec *rbbiDataDecoder) stateTable(table *rbbiStateTable) {
+	table.stateCount = dec.uint32()
+	table.rowLength = dec.uint32()
+	table.dictCategoriesStart = dec.uint32()
+	table.lookaheadResultsSize = dec.uint32()
+
+	flags := dec.uint8()
+	table.lookaheadHardBreak = flags&rbbiStateTableFlagLookaheadHardBreak != 0
+	table.bofRequired = flags&rbbiStateTableFlagBOFRequired != 0
+	table.valueWidth = rbbiStateTableValueWidth(dec.uint8())
+
+	switch table.valueWidth {
+	case rbbiStateTableValueWidth8:
+		table.table8 = []uint8(dec.take(dec.count(1)))
+	case rbbiStateTableValueWidth16:
+		table.table16 = make([]uint16, dec.count(2))
+		for i := range table.table16 {
+			table.table16[i] = dec.uint16()
+		}
+	default:
+		if dec.err == nil {
+			dec.err = corruptTableError("unknown value width %v of a state table", table.valueWidth)
+		}
+	}
+}
@@ -0,0 +1,347 @@
+package rbbi
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/adler32"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var encodedRules = map[string]*Rules{
+	"character": &characterRules,
+	"line":      &lineRules,
+	"sentence":  &sentenceRules,
+	"word":      &wordRules,
+}
+
+// Update the checksum of encoded tables after modifying them.
+func updateChecksum(encoded []byte) {
+	binary.LittleEndian.PutUint32(encoded[6:], adler32.Checksum(encoded[rbbiDataHeaderLength:]))
+}
+
+// Return the tables encoded in the format described in rbbi_data_encoding.go.
+// The embedded tables are generated by gen_tables.go, so this is only used to
+// test the decoder using modified tables.
+func (d *rbbiData) encode() []byte {
+	e := rbbiDataEncoder{}
+	e.bytes = append(e.bytes, rbbiDataMagic...)
+	e.uint16(rbbiDataVersion)
+	e.uint32(0) // Checksum
+
+	e.uint32(d.categoryCount)
+	e.uint32(uint32(len(d.categoryNames)))
+	for _, name := range d.categoryNames {
+		e.uint8(uint8(len(name)))
+		e.bytes = append(e.bytes, name...)
+	}
+
+	e.stateTable(&d.forwardTable)
+	e.stateTable(&d.reverseTable)
+
+	e.uint32(uint32(len(d.ruleStatusTable)))
+	for _, value := range d.ruleStatusTable {
+		e.uint32(uint32(value))
+	}
+
+	t := &d.trie
+	e.uint8(uint8(t.trieType))
+	e.uint8(uint8(t.valueWidth))
+	e.uint32(uint32(t.dataLength))
+	e.uint16(t.index3NullOffset)
+	e.uint16(t.dataNullOffset)
+	e.uint32(uint32(t.highStart))
+	e.uint32(t.shifted12HighStart)
+	e.uint32(t.nullValueOffset)
+	e.uint32(t.nullValue)
+
+	e.uint32(uint32(len(t.index)))
+	for _, value := range t.index {
+		e.uint16(value)
+	}
+
+	switch t.valueWidth {
+	case ucpTrieValueWidth8:
+		e.uint32(uint32(len(t.data8)))
+		e.bytes = append(e.bytes, t.data8...)
+	case ucpTrieValueWidth16:
+		e.uint32(uint32(len(t.data16)))
+		for _, value := range t.data16 {
+			e.uint16(value)
+		}
+	case ucpTrieValueWidth32:
+		e.uint32(uint32(len(t.data32)))
+		for _, value := range t.data32 {
+			e.uint32(value)
+		}
+	}
+
+	binary.LittleEndian.PutUint32(e.bytes[6:], adler32.Checksum(e.bytes[rbbiDataHeaderLength:]))
+	return e.bytes
+}
+
+// An encoder that appends the values of the encoding in order.
+type rbbiDataEncoder struct {
+	bytes []byte
+}
+
+// Append a uint8 to the encoding.
+func (e *rbbiDataEncoder) uint8(value uint8) {
+	e.bytes = append(e.bytes, value)
+}
+
+// Append a little endian uint16 to the encoding.
+func (e *rbbiDataEncoder) uint16(value uint16) {
+	e.bytes = append(e.bytes, byte(value), byte(value>>8))
+}
+
+// Append a little endian uint32 to the encoding.
+func (e *rbbiDataEncoder) uint32(value uint32) {
+	e.bytes = append(e.bytes, byte(value), byte(value>>8), byte(value>>16), byte(value>>24))
+}
+
+// Append a state table to the encoding.
+func (e *rbbiDataEncoder) stateTable(table *rbbiStateTable) {
+	e.uint32(table.stateCount)
+	e.uint32(table.rowLength)
+	e.uint32(table.dictCategoriesStart)
+	e.uint32(table.lookaheadResultsSize)
+
+	var flags uint8
+	if table.lookaheadHardBreak {
+		flags |= rbbiStateTableFlagLookaheadHardBreak
+	}
+
+	if table.bofRequired {
+		flags |= rbbiStateTableFlagBOFRequired
+	}
+
+	e.uint8(flags)
+	e.uint8(uint8(table.valueWidth))
+
+	if table.valueWidth == rbbiStateTableValueWidth16 {
+		e.uint32(uint32(len(table.table16)))
+		for _, value := range table.table16 {
+			e.uint16(value)
+		}
+	} else {
+		e.uint32(uint32(len(table.table8)))
+		e.bytes = append(e.bytes, table.table8...)
+	}
+}
+
+func TestEncodingRoundTrip(t *testing.T) {
+	for name, rules := range encodedRules {
+		data := decodedData(rules)
+
+		encoded := data.encode()
+		if string(encoded) != *rules.encoded {
+			t.Errorf("Encoding the decoded %v tables gives different bytes", name)
+		}
+
+		decoded, err := decodeRBBIData(string(encoded))
+		if err != nil {
+			t.Fatalf("Decoding the %v tables failed: %v", name, err)
+		}
+
+		if !reflect.DeepEqual(decoded, data) {
+			t.Errorf("Decoding the encoded %v tables gives different tables", name)
+		}
+	}
+}
+
+func TestEncodingRoundTrip16(t *testing.T) {
+	data := *decodedData(&wordRules)
+	data.forwardTable = widenTable(data.forwardTable, 300)
+	data.reverseTable = widenTable(data.reverseTable, 300)
+
+	decoded, err := decodeRBBIData(string(data.encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(decoded, &data) {
+		t.Error("Decoding encoded 16-bit state tables gives different tables")
+	}
+}
+
+func TestEncodingChecksum(t *testing.T) {
+	encoded := []byte(rbbiCharacterData)
+
+	// Every modified byte after the header must be detected
+	for i := rbbiDataHeaderLength; i < len(encoded); i++ {
+		encoded[i] ^= 0x10
+
+		if _, err := decodeRBBIData(string(encoded)); !errors.Is(err, ErrCorruptTable) {
+			t.Fatalf("Modifying byte %v was not detected: %v", i, err)
+		}
+
+		encoded[i] ^= 0x10
+	}
+
+	// The checksum itself
+	encoded[6] ^= 0x01
+	if _, err := decodeRBBIData(string(encoded)); !errors.Is(err, ErrCorruptTable) {
+		t.Errorf("Modifying the checksum was not detected: %v", err)
+	}
+}
+
+func TestEncodingHeader(t *testing.T) {
+	for name, encoded := range map[string]string{
+		"empty":    "",
+		"short":    "RBBI",
+		"magic":    "ICU!" + rbbiCharacterData[4:],
+		"version":  rbbiCharacterData[:4] + "\x02\x00" + rbbiCharacterData[6:],
+		"trailing": rbbiCharacterData + "\x00",
+	} {
+		if _, err := decodeRBBIData(encoded); !errors.Is(err, ErrCorruptTable) {
+			t.Errorf("Decoding tables with an invalid %v gave error %v", name, err)
+		}
+	}
+}
+
+func TestEncodingTruncated(t *testing.T) {
+	// Truncated tables with a valid checksum, so that decoding runs out of
+	// bytes instead of failing the checksum
+	for length := rbbiDataHeaderLength; length < len(rbbiCharacterData); length += 7 {
+		encoded := []byte(rbbiCharacterData[:length])
+		updateChecksum(encoded)
+
+		if _, err := decodeRBBIData(string(encoded)); !errors.Is(err, ErrCorruptTable) {
+			t.Fatalf("Decoding tables truncated to %v bytes gave error %v", length, err)
+		}
+	}
+
+	// A huge array length must not be allocated
+	encoded := []byte(rbbiCharacterData)
+	binary.LittleEndian.PutUint32(encoded[rbbiDataHeaderLength+4:], 0xffffffff)
+	updateChecksum(encoded)
+
+	if _, err := decodeRBBIData(string(encoded)); !errors.Is(err, ErrCorruptTable) {
+		t.Errorf("Decoding tables with a huge array length gave error %v", err)
+	}
+}
+
+func TestEncodingCorruptRules(t *testing.T) {
+	encoded := rbbiWordData[:len(rbbiWordData)-1]
+	rules := &Rules{encoded: &encoded}
+
+	iter := rules.NewRBBI()
+	iter.SetCursor(NewStringCursor("Hello"))
+
+	if _, ok := iter.Next(); ok {
+		t.Error("Next was ok with corrupt encoded tables")
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+
+	if iter.RuleStatus() != 0 || iter.RuleStatuses() != nil {
+		t.Error("Corrupt encoded tables have rule statuses")
+	}
+
+	if rules.Category('a') != -1 || rules.CategoryCount() != 0 || rules.CategoryName(0) != "" {
+		t.Error("Corrupt encoded tables have categories")
+	}
+}
+
+func TestEncodingHugeLookaheadResultsSize(t *testing.T) {
+	// Encoded tables that decode fine, but have a lookahead results size
+	// that can't be allocated
+	data := *decodedData(&characterRules)
+	data.forwardTable.lookaheadResultsSize = 0xffffffff
+	encoded := string(data.encode())
+
+	if _, err := decodeRBBIData(encoded); err != nil {
+		t.Fatalf("Decoding the tables failed: %v", err)
+	}
+
+	// NewRBBI must validate the tables before allocating the lookahead
+	// results
+	iter := (&Rules{encoded: &encoded}).NewRBBI()
+	if len(iter.lookaheadMatches) != 0 {
+		t.Errorf("Allocated %v lookahead results for unvalidated tables", len(iter.lookaheadMatches))
+	}
+
+	iter.SetCursor(NewStringCursor("Hello"))
+	if _, ok := iter.Next(); ok {
+		t.Error("Next was ok with a huge lookahead results size")
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+}
+
+func TestEncodingStateCountOverflow(t *testing.T) {
+	// The row lengths of the character tables are multiples of 8, so adding
+	// 2^29 states overflows the 32-bit product of the state count and the
+	// row length to the actual size of the table
+	for _, reverse := range []bool{false, true} {
+		data := *decodedData(&characterRules)
+
+		table := &data.forwardTable
+		if reverse {
+			table = &data.reverseTable
+		}
+
+		if table.rowLength%8 != 0 {
+			t.Fatalf("Row length %v is not a multiple of 8", table.rowLength)
+		}
+
+		table.stateCount += 1 << 29
+		encoded := string(data.encode())
+
+		iter := (&Rules{encoded: &encoded}).NewRBBI()
+		iter.SetCursor(NewStringCursor("Hello"))
+
+		if _, ok := iter.Next(); ok {
+			t.Errorf("Next was ok with an overflowing state count (reverse %v)", reverse)
+		}
+
+		expectError(t, iter, ErrCorruptTable)
+	}
+}
+
+// The embedded tables must be the output of the generator for the text files
+// in data/, so that changes to either are reviewed together.
+func TestGeneratedTables(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping running the generator in short mode")
+	}
+
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("The go command is not available")
+	}
+
+	dir := t.TempDir()
+	if output, err := exec.Command(goTool, "run", "gen_tables.go", "-out", dir).CombinedOutput(); err != nil {
+		t.Fatalf("Running the generator failed: %v\n%s", err, output)
+	}
+
+	for name, rules := range encodedRules {
+		generated, err := os.ReadFile(filepath.Join(dir, name+".rbbi"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(generated) != *rules.encoded {
+			t.Errorf("The embedded %v tables differ from the output of the generator, run go generate", name)
+		}
+	}
+}
+
+func BenchmarkDecodeRBBIData(b *testing.B) {
+	for name, rules := range encodedRules {
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(*rules.encoded)))
+
+			for i := 0; i < b.N; i++ {
+				if _, err := decodeRBBIData(*rules.encoded); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package rbbi
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Convert an 8-bit state table to a 16-bit state table. The states other than
+// the stop and start states are moved up by the provided offset, and the
+// states in between are left unused, so that an offset of at least 254 gives
+// a table that can't be represented using 8 bits.
+func widenTable(table rbbiStateTable, offset int) rbbiStateTable {
+	rowLength := int(table.rowLength)
+	stateCount := int(table.stateCount) + offset
+
+	mapState := func(state int) int {
+		if state <= int(rbbiStateStart) {
+			return state
+		}
+
+		return state + offset
+	}
+
+	wide := table
+	wide.valueWidth = rbbiStateTableValueWidth16
+	wide.stateCount = uint32(stateCount)
+	wide.table8 = nil
+	wide.table16 = make([]uint16, stateCount*rowLength)
+
+	for state := 0; state < int(table.stateCount); state++ {
+		from := state * rowLength
+		to := mapState(state) * rowLength
+
+		for i := 0; i < rowLength; i++ {
+			value := int(table.table8[from+i])
+			if i >= rbbiRowNextStates {
+				value = mapState(value)
+			}
+
+			wide.table16[to+i] = uint16(value)
+		}
+	}
+
+	return wide
+}
+
+func TestStateTable16(t *testing.T) {
+	str := "The quick (“brown”) fox can’t jump 32.3 feet, right?\nGröße 🐨🏴‍☠️❤️‍🔥 日本語のテキスト。 क्षत्रिय"
+
+	for _, rules := range []*Rules{CharacterRules(), LineRules(), SentenceRules(), WordRules()} {
+		data := *decodedData(rules)
+		data.forwardTable = widenTable(data.forwardTable, 300)
+		data.reverseTable = widenTable(data.reverseTable, 300)
+
+		wide := &Rules{data: &data}
+		if err := wide.validate(); err != nil {
+			t.Fatalf("Invalid 16-bit rules: %v", err)
+		}
+
+		expected := allBoundaries(rules.NewRBBI(), str)
+		iter := wide.NewRBBI()
+
+		if boundaries := allBoundaries(iter, str); !reflect.DeepEqual(boundaries, expected) {
+			t.Errorf("Invalid boundaries %v using 16-bit tables, expected %v", boundaries, expected)
+		}
+
+		reference := rules.NewRBBI()
+		reference.SetCursor(NewStringCursor(str))
+
+		for _, position := range expected[1:] {
+			expectedBreak, _ := reference.Preceding(position)
+
+			if breakpoint, ok := iter.Preceding(position); !ok || breakpoint != expectedBreak || iter.RuleStatus() != reference.RuleStatus() {
+				t.Errorf("Invalid preceding break %v for position %v using 16-bit tables", breakpoint, position)
+			}
+		}
+	}
+}
+
+func TestStateTable16Corrupt(t *testing.T) {
+	data := *decodedData(&characterRules)
+	data.forwardTable = widenTable(data.forwardTable, 300)
+	data.forwardTable.table16[int(data.forwardTable.rowLength)+rbbiRowNextStates+3] = 1000
+
+	iter := newBrokenRBBI(&data, "a")
+	if _, ok := iter.Next(); ok {
+		t.Error("Next was ok with a corrupt 16-bit state table")
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+}
@@ -1,3141 +0,0 @@
-// This file is auto-generated by maketable.py
-
-package rbbi
-
-// Lookup tables for line breaks.
-var rbbiLineData rbbiData = rbbiData{
-	categoryCount: 45,
-
-	forwardTable: rbbiStateTable{
-		stateCount:           106,
-		rowLength:            48,
-		dictCategoriesStart:  43,
-		lookaheadResultsSize: 7,
-
-		lookaheadHardBreak: false,
-		bofRequired:        false,
-		valueWidth:         rbbiStateTableValueWidth8,
-
-		rows: []rbbiStateTableRow{
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 2, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 26, 27, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 2,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 2, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 26, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 2,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 3, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 0, 0, 0, 0, 0, 0, 7, 25, 35, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 4, 4, 5, 6, 7, 0, 0, 0, 0, 0, 13,
-					36, 0, 16, 0, 18, 0, 0, 0, 0, 0, 0, 0, 25, 0, 0, 0,
-					0, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 7, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 37, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 8, 3, 4, 4, 5, 38, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 8, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 8,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 9, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 39, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 9,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 10, 3, 4, 4, 5, 6, 7, 8, 9, 0, 0, 40, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 22, 23, 24, 7, 25, 41, 3, 0,
-					7, 19, 29, 40, 30, 23, 24, 0, 32, 0, 29, 9, 10,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 11, 3, 4, 4, 5, 6, 7, 8, 9, 0, 0, 40, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 42, 3, 0,
-					7, 19, 0, 40, 0, 0, 0, 0, 0, 0, 0, 9, 11,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 12, 3, 4, 4, 5, 43, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 12, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 12,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 3, 4, 4, 5, 44, 7, 8, 9, 0, 0, 0, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 45, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 13,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 14, 3, 4, 4, 5, 6, 7, 8, 9, 0, 0, 0, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 46, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 14,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 15, 3, 4, 4, 5, 6, 7, 8, 9, 0, 0, 0, 13,
-					14, 34, 16, 17, 18, 0, 0, 0, 0, 0, 0, 7, 25, 47, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 15,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 16, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 21, 0, 0, 0, 7, 25, 48, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 16,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 17, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 49,
-					50, 34, 51, 17, 52, 19, 0, 21, 0, 0, 0, 7, 25, 53, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 17,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 18, 3, 4, 4, 5, 44, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 54, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 18,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 19, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 19, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 19,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 20, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 55, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 0, 32, 33, 29, 9, 20,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 21, 56, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 57, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 58, 56, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 21,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 22, 3, 4, 4, 5, 6, 7, 8, 0, 0, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 22, 23, 0, 7, 25, 59, 3, 0,
-					7, 19, 0, 0, 0, 23, 24, 0, 0, 0, 0, 0, 22,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 23, 3, 4, 4, 5, 6, 7, 8, 0, 0, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 23, 24, 7, 25, 60, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 23,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 24, 3, 4, 4, 5, 6, 7, 8, 0, 0, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 24, 7, 25, 61, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 24,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 2,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 62, 62, 4, 4, 5, 63, 62, 62, 62, 62, 62, 62, 62,
-					62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 25, 62, 62, 62,
-					62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 2, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 26, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 2,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 27, 3, 4, 4, 5, 6, 7, 8, 9, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 0, 0, 0, 0, 0, 0, 7, 25, 64, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 27,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 28, 3, 4, 4, 5, 65, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 66, 3, 28,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 28,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 29, 3, 4, 4, 5, 6, 7, 8, 0, 0, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 67, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 29,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 30, 3, 4, 4, 5, 6, 7, 8, 0, 0, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 68, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 29, 0, 30,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 31, 0, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 0, 16, 0, 18, 19, 0, 0, 0, 0, 0, 0, 25, 69, 0, 0,
-					0, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 31,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 32, 3, 4, 4, 5, 6, 7, 8, 0, 0, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 70, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 29, 0, 32,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 33, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 71, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 72, 0, 0, 33,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 34, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 17, 18, 0, 0, 0, 0, 0, 0, 7, 25, 73, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 34,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 3, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 35, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 3,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 3,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 75, 3, 4, 4, 5, 6, 7, 8, 9, 76, 76, 76, 13,
-					14, 34, 16, 0, 18, 19, 76, 21, 76, 76, 76, 7, 25, 77, 3, 76,
-					7, 19, 76, 76, 76, 76, 76, 76, 76, 76, 76, 9, 75,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 7, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 37, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 7,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 4, 4, 5, 38, 7, 0, 0, 0, 0, 12, 13,
-					36, 0, 16, 0, 18, 0, 0, 0, 0, 0, 0, 0, 25, 0, 0, 0,
-					0, 19, 0, 12, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 9, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 39, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 9,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 40, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					78, 0, 0, 17, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 10, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 41, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 10,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 11, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 42, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 11,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 79, 3, 4, 4, 5, 43, 7, 8, 9, 10, 11, 12, 13,
-					80, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 81, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 79,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 4, 4, 5, 44, 7, 0, 0, 0, 0, 0, 13,
-					36, 0, 16, 0, 18, 0, 0, 0, 0, 0, 0, 7, 25, 0, 0, 0,
-					0, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 3, 4, 4, 5, 44, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 45, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 13,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 14, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 46, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 14,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 15, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 47, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 15,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 16, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 48, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 16,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 49, 3, 4, 4, 5, 44, 7, 8, 9, 10, 11, 0, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 82, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 49,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 50, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 0, 49,
-					50, 34, 51, 17, 52, 19, 0, 21, 0, 0, 0, 7, 25, 83, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 50,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 51, 3, 4, 4, 5, 6, 7, 8, 0, 10, 11, 0, 49,
-					50, 34, 51, 17, 52, 19, 0, 21, 0, 0, 0, 7, 25, 84, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 51,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 52, 3, 4, 4, 5, 44, 7, 8, 0, 10, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 85, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 52,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 17, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 49,
-					50, 34, 51, 17, 52, 19, 20, 21, 22, 23, 24, 7, 25, 53, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 17,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 18, 3, 4, 4, 5, 44, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 54, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 18,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 20, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 55, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 20,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 56, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 86, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 0, 32, 33, 29, 9, 56,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 57, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 87, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 0, 32, 33, 29, 9, 57,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 21, 56, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 57, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 58, 56, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 21,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 22, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 59, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 22,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 23, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 60, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 23,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 24, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 61, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 24,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 2,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 62, 62, 4, 4, 5, 63, 88, 62, 62, 62, 62, 62, 89,
-					90, 62, 91, 62, 92, 62, 62, 62, 62, 62, 62, 62, 25, 62, 62, 62,
-					62, 93, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 27, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 64, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 27,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 4, 4, 5, 65, 7, 0, 0, 0, 0, 0, 13,
-					36, 0, 16, 0, 18, 0, 0, 0, 0, 0, 0, 0, 25, 0, 0, 28,
-					0, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 28, 3, 4, 4, 5, 65, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 66, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 28,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 29, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 67, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 29,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 30, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 68, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 30,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 31, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 69, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 31,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 32, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 70, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 32,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 33, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 71, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 94, 29, 9, 33,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 4,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 95, 3, 4, 4, 5, 6, 7, 8, 96, 96, 96, 96, 13,
-					14, 34, 16, 96, 18, 19, 96, 96, 96, 96, 96, 7, 25, 97, 3, 96,
-					7, 19, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 95,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 34, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 73, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 34,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 5,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 75, 3, 4, 4, 5, 6, 7, 8, 9, 98, 98, 98, 13,
-					14, 34, 16, 0, 18, 19, 98, 21, 98, 98, 98, 7, 25, 77, 3, 98,
-					7, 19, 98, 98, 98, 98, 98, 98, 98, 98, 98, 9, 75,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 3,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 75, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 0, 18, 19, 20, 21, 22, 23, 24, 7, 25, 77, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 75,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 78, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 17, 0, 0, 0, 0, 0, 0, 0, 0, 0, 78, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 78,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 79, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 81, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 79,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 3,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 99, 3, 4, 4, 5, 6, 7, 8, 9, 76, 76, 76, 13,
-					14, 34, 16, 17, 18, 19, 76, 21, 76, 76, 76, 7, 25, 100, 3, 76,
-					7, 19, 76, 76, 76, 76, 76, 76, 76, 76, 76, 9, 99,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 79, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 81, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 79,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 49, 3, 4, 4, 5, 44, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 82, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 49,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 50, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 49,
-					50, 34, 51, 17, 52, 19, 20, 21, 22, 23, 24, 7, 25, 83, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 50,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 51, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 49,
-					50, 34, 51, 17, 52, 19, 20, 21, 22, 23, 24, 7, 25, 84, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 51,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 52, 3, 4, 4, 5, 44, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 85, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 52,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 56, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 86, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 56,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 57, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 87, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 57,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 7, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 37, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 3, 4, 4, 5, 44, 7, 8, 9, 0, 0, 0, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 45, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 13,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 3,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 75, 3, 4, 4, 5, 6, 7, 8, 9, 76, 76, 76, 13,
-					14, 34, 16, 0, 18, 19, 76, 21, 76, 76, 76, 7, 25, 77, 3, 76,
-					7, 19, 76, 76, 76, 76, 76, 76, 76, 76, 76, 9, 75,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 16, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 21, 0, 0, 0, 7, 25, 48, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 16,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 18, 3, 4, 4, 5, 44, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 54, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 18,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 19, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 19, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 19,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 4,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 101, 3, 4, 4, 5, 6, 7, 8, 96, 96, 96, 96, 13,
-					14, 34, 16, 96, 18, 19, 96, 96, 96, 96, 96, 7, 25, 102, 3, 96,
-					7, 19, 96, 96, 96, 96, 96, 96, 96, 103, 96, 96, 101,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 6,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 95, 3, 4, 4, 5, 6, 7, 8, 104, 104, 104, 104, 13,
-					14, 34, 16, 104, 18, 19, 104, 104, 104, 104, 104, 7, 25, 97, 3, 104,
-					7, 19, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 95,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 4,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 95, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 97, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 95,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 5,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 5,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 99, 3, 4, 4, 5, 6, 7, 8, 9, 98, 98, 98, 13,
-					14, 34, 16, 17, 18, 19, 98, 21, 98, 98, 98, 7, 25, 100, 3, 98,
-					7, 19, 98, 98, 98, 98, 98, 98, 98, 98, 98, 9, 99,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 99, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 100, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 99,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 6,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 101, 3, 4, 4, 5, 6, 7, 8, 104, 104, 104, 104, 13,
-					14, 34, 16, 104, 18, 19, 104, 104, 104, 104, 104, 7, 25, 102, 3, 104,
-					7, 19, 104, 104, 104, 104, 104, 104, 104, 105, 104, 104, 101,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 101, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 102, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 94, 29, 9, 101,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 4,
-				lookahead: 4,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 95, 3, 4, 4, 5, 6, 7, 8, 96, 96, 96, 96, 13,
-					14, 34, 16, 96, 18, 19, 96, 96, 96, 96, 96, 7, 25, 97, 3, 96,
-					7, 19, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 95,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 6,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 6,
-				lookahead: 4,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 95, 3, 4, 4, 5, 6, 7, 8, 96, 96, 96, 96, 13,
-					14, 34, 16, 96, 18, 19, 96, 96, 96, 96, 96, 7, 25, 97, 3, 96,
-					7, 19, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 95,
-				},
-			},
-		},
-	},
-
-	reverseTable: rbbiStateTable{
-		stateCount:           106,
-		rowLength:            48,
-		dictCategoriesStart:  43,
-		lookaheadResultsSize: 7,
-
-		lookaheadHardBreak: false,
-		bofRequired:        false,
-		valueWidth:         rbbiStateTableValueWidth8,
-
-		rows: []rbbiStateTableRow{
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 2, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 26, 27, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 2,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 2, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 26, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 2,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 3, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 0, 0, 0, 0, 0, 0, 7, 25, 35, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 4, 4, 5, 6, 7, 0, 0, 0, 0, 0, 13,
-					36, 0, 16, 0, 18, 0, 0, 0, 0, 0, 0, 0, 25, 0, 0, 0,
-					0, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 7, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 37, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 8, 3, 4, 4, 5, 38, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 8, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 8,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 9, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 39, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 9,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 10, 3, 4, 4, 5, 6, 7, 8, 9, 0, 0, 40, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 22, 23, 24, 7, 25, 41, 3, 0,
-					7, 19, 29, 40, 30, 23, 24, 0, 32, 0, 29, 9, 10,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 11, 3, 4, 4, 5, 6, 7, 8, 9, 0, 0, 40, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 42, 3, 0,
-					7, 19, 0, 40, 0, 0, 0, 0, 0, 0, 0, 9, 11,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 12, 3, 4, 4, 5, 43, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 12, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 12,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 3, 4, 4, 5, 44, 7, 8, 9, 0, 0, 0, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 45, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 13,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 14, 3, 4, 4, 5, 6, 7, 8, 9, 0, 0, 0, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 46, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 14,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 15, 3, 4, 4, 5, 6, 7, 8, 9, 0, 0, 0, 13,
-					14, 34, 16, 17, 18, 0, 0, 0, 0, 0, 0, 7, 25, 47, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 15,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 16, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 21, 0, 0, 0, 7, 25, 48, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 16,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 17, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 49,
-					50, 34, 51, 17, 52, 19, 0, 21, 0, 0, 0, 7, 25, 53, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 17,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 18, 3, 4, 4, 5, 44, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 54, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 18,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 19, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 19, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 19,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 20, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 55, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 0, 32, 33, 29, 9, 20,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 21, 56, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 57, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 58, 56, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 21,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 22, 3, 4, 4, 5, 6, 7, 8, 0, 0, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 22, 23, 0, 7, 25, 59, 3, 0,
-					7, 19, 0, 0, 0, 23, 24, 0, 0, 0, 0, 0, 22,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 23, 3, 4, 4, 5, 6, 7, 8, 0, 0, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 23, 24, 7, 25, 60, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 23,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 24, 3, 4, 4, 5, 6, 7, 8, 0, 0, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 24, 7, 25, 61, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 24,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 2,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 62, 62, 4, 4, 5, 63, 62, 62, 62, 62, 62, 62, 62,
-					62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 25, 62, 62, 62,
-					62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 2, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 26, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 2,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 27, 3, 4, 4, 5, 6, 7, 8, 9, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 0, 0, 0, 0, 0, 0, 7, 25, 64, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 27,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 28, 3, 4, 4, 5, 65, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 66, 3, 28,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 28,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 29, 3, 4, 4, 5, 6, 7, 8, 0, 0, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 67, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 29,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 30, 3, 4, 4, 5, 6, 7, 8, 0, 0, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 68, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 29, 0, 30,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 31, 0, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 0, 16, 0, 18, 19, 0, 0, 0, 0, 0, 0, 25, 69, 0, 0,
-					0, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 31,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 32, 3, 4, 4, 5, 6, 7, 8, 0, 0, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 70, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 29, 0, 32,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 33, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 71, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 72, 0, 0, 33,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 34, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 17, 18, 0, 0, 0, 0, 0, 0, 7, 25, 73, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 34,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 3, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 35, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 3,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 3,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 75, 3, 4, 4, 5, 6, 7, 8, 9, 76, 76, 76, 13,
-					14, 34, 16, 0, 18, 19, 76, 21, 76, 76, 76, 7, 25, 77, 3, 76,
-					7, 19, 76, 76, 76, 76, 76, 76, 76, 76, 76, 9, 75,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 7, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 37, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 7,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 4, 4, 5, 38, 7, 0, 0, 0, 0, 12, 13,
-					36, 0, 16, 0, 18, 0, 0, 0, 0, 0, 0, 0, 25, 0, 0, 0,
-					0, 19, 0, 12, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 9, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 39, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 9,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 40, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					78, 0, 0, 17, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 10, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 41, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 10,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 11, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 42, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 11,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 79, 3, 4, 4, 5, 43, 7, 8, 9, 10, 11, 12, 13,
-					80, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 81, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 79,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 4, 4, 5, 44, 7, 0, 0, 0, 0, 0, 13,
-					36, 0, 16, 0, 18, 0, 0, 0, 0, 0, 0, 7, 25, 0, 0, 0,
-					0, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 3, 4, 4, 5, 44, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 45, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 13,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 14, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 46, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 14,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 15, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 47, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 15,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 16, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 48, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 16,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 49, 3, 4, 4, 5, 44, 7, 8, 9, 10, 11, 0, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 82, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 49,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 50, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 0, 49,
-					50, 34, 51, 17, 52, 19, 0, 21, 0, 0, 0, 7, 25, 83, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 50,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 51, 3, 4, 4, 5, 6, 7, 8, 0, 10, 11, 0, 49,
-					50, 34, 51, 17, 52, 19, 0, 21, 0, 0, 0, 7, 25, 84, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 51,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 52, 3, 4, 4, 5, 44, 7, 8, 0, 10, 11, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 85, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 52,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 17, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 49,
-					50, 34, 51, 17, 52, 19, 20, 21, 22, 23, 24, 7, 25, 53, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 17,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 18, 3, 4, 4, 5, 44, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 54, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 18,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 20, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 55, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 20,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 56, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 86, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 0, 32, 33, 29, 9, 56,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 57, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 87, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 0, 32, 33, 29, 9, 57,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 21, 56, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 57, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 58, 56, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 21,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 22, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 59, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 22,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 23, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 60, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 23,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 24, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 61, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 24,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 2,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 62, 62, 4, 4, 5, 63, 88, 62, 62, 62, 62, 62, 89,
-					90, 62, 91, 62, 92, 62, 62, 62, 62, 62, 62, 62, 25, 62, 62, 62,
-					62, 93, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 27, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 64, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 27,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 4, 4, 5, 65, 7, 0, 0, 0, 0, 0, 13,
-					36, 0, 16, 0, 18, 0, 0, 0, 0, 0, 0, 0, 25, 0, 0, 28,
-					0, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 28, 3, 4, 4, 5, 65, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 66, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 28,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 29, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 67, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 29,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 30, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 68, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 30,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 31, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 69, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 31,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 32, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 70, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 32,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 33, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 71, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 94, 29, 9, 33,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 4,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 95, 3, 4, 4, 5, 6, 7, 8, 96, 96, 96, 96, 13,
-					14, 34, 16, 96, 18, 19, 96, 96, 96, 96, 96, 7, 25, 97, 3, 96,
-					7, 19, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 95,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 34, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 73, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 34,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 5,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 75, 3, 4, 4, 5, 6, 7, 8, 9, 98, 98, 98, 13,
-					14, 34, 16, 0, 18, 19, 98, 21, 98, 98, 98, 7, 25, 77, 3, 98,
-					7, 19, 98, 98, 98, 98, 98, 98, 98, 98, 98, 9, 75,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 3,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 75, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 0, 18, 19, 20, 21, 22, 23, 24, 7, 25, 77, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 75,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 78, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 17, 0, 0, 0, 0, 0, 0, 0, 0, 0, 78, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 78,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 79, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 81, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 79,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 3,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 99, 3, 4, 4, 5, 6, 7, 8, 9, 76, 76, 76, 13,
-					14, 34, 16, 17, 18, 19, 76, 21, 76, 76, 76, 7, 25, 100, 3, 76,
-					7, 19, 76, 76, 76, 76, 76, 76, 76, 76, 76, 9, 99,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 79, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 81, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 79,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 49, 3, 4, 4, 5, 44, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 82, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 49,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 50, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 49,
-					50, 34, 51, 17, 52, 19, 20, 21, 22, 23, 24, 7, 25, 83, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 50,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 51, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 49,
-					50, 34, 51, 17, 52, 19, 20, 21, 22, 23, 24, 7, 25, 84, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 51,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 52, 3, 4, 4, 5, 44, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 85, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 52,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 56, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 86, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 56,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 57, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 87, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 57,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 7, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 37, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 3, 4, 4, 5, 44, 7, 8, 9, 0, 0, 0, 13,
-					14, 34, 16, 17, 18, 19, 0, 21, 0, 0, 0, 7, 25, 45, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 13,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 3,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 75, 3, 4, 4, 5, 6, 7, 8, 9, 76, 76, 76, 13,
-					14, 34, 16, 0, 18, 19, 76, 21, 76, 76, 76, 7, 25, 77, 3, 76,
-					7, 19, 76, 76, 76, 76, 76, 76, 76, 76, 76, 9, 75,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 16, 3, 4, 4, 5, 6, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 21, 0, 0, 0, 7, 25, 48, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 16,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 18, 3, 4, 4, 5, 44, 7, 8, 0, 0, 0, 0, 13,
-					14, 34, 16, 0, 18, 19, 0, 0, 0, 0, 0, 7, 25, 54, 3, 0,
-					7, 19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 18,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 2,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 19, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 19, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 19,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 4,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 101, 3, 4, 4, 5, 6, 7, 8, 96, 96, 96, 96, 13,
-					14, 34, 16, 96, 18, 19, 96, 96, 96, 96, 96, 7, 25, 102, 3, 96,
-					7, 19, 96, 96, 96, 96, 96, 96, 96, 103, 96, 96, 101,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 6,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 95, 3, 4, 4, 5, 6, 7, 8, 104, 104, 104, 104, 13,
-					14, 34, 16, 104, 18, 19, 104, 104, 104, 104, 104, 7, 25, 97, 3, 104,
-					7, 19, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 95,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 4,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 95, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 97, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 95,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 5,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 5,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 99, 3, 4, 4, 5, 6, 7, 8, 9, 98, 98, 98, 13,
-					14, 34, 16, 17, 18, 19, 98, 21, 98, 98, 98, 7, 25, 100, 3, 98,
-					7, 19, 98, 98, 98, 98, 98, 98, 98, 98, 98, 9, 99,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 99, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 100, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 33, 29, 9, 99,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 6,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 101, 3, 4, 4, 5, 6, 7, 8, 104, 104, 104, 104, 13,
-					14, 34, 16, 104, 18, 19, 104, 104, 104, 104, 104, 7, 25, 102, 3, 104,
-					7, 19, 104, 104, 104, 104, 104, 104, 104, 105, 104, 104, 101,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 101, 3, 4, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13,
-					14, 34, 16, 17, 18, 19, 20, 21, 22, 23, 24, 7, 25, 102, 3, 28,
-					7, 19, 29, 12, 30, 23, 24, 31, 32, 94, 29, 9, 101,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 4,
-				lookahead: 4,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 95, 3, 4, 4, 5, 6, 7, 8, 96, 96, 96, 96, 13,
-					14, 34, 16, 96, 18, 19, 96, 96, 96, 96, 96, 7, 25, 97, 3, 96,
-					7, 19, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 95,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 6,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 6,
-				lookahead: 4,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 74, 0, 95, 3, 4, 4, 5, 6, 7, 8, 96, 96, 96, 96, 13,
-					14, 34, 16, 96, 18, 19, 96, 96, 96, 96, 96, 7, 25, 97, 3, 96,
-					7, 19, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 95,
-				},
-			},
-		},
-	},
-
-	trie: ucpTrie{
-		trieType:           ucpTrieTypeFast,
-		valueWidth:         ucpTrieValueWidth8,
-		dataLength:         9556,
-		index3NullOffset:   864,
-		dataNullOffset:     191,
-		highStart:          918016,
-		shifted12HighStart: 225,
-		nullValueOffset:    191,
-
-		index: []uint16{
-			0, 64, 127, 191, 191, 191, 191, 191, 191, 191, 191, 247, 311, 360, 191, 191,
-			191, 191, 423, 191, 191, 191, 478, 542, 597, 650, 191, 699, 757, 810, 836, 900,
-			964, 1010, 1050, 1104, 1164, 1226, 1289, 1351, 1289, 1415, 1289, 1479, 1289, 1538, 1600, 1662,
-			1726, 1788, 1851, 1913, 1977, 2039, 2102, 2156, 2219, 2283, 2346, 2410, 2473, 2537, 2595, 2659,
-			2723, 2787, 2849, 191, 2913, 2945, 2977, 3017, 191, 191, 191, 191, 191, 3081, 191, 191,
-			3114, 191, 191, 191, 191, 191, 191, 191, 191, 191, 3178, 3207, 3253, 3308, 3372, 3424,
-			3486, 191, 3545, 191, 3587, 3647, 3711, 3765, 3806, 3849, 3913, 806, 3972, 4031, 4095, 4153,
-			4205, 4269, 191, 4333, 191, 191, 191, 311, 191, 191, 191, 191, 191, 191, 191, 194,
-			4397, 4459, 4507, 4569, 4630, 191, 191, 191, 4676, 191, 191, 4696, 4752, 191, 191, 4795,
-			191, 191, 191, 191, 191, 191, 191, 191, 4843, 4903, 4967, 5028, 5089, 5126, 191, 5185,
-			191, 191, 191, 191, 191, 191, 5246, 5286, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 5348, 191, 5412, 191, 279, 5476, 5540, 5604, 5631, 5695, 5695, 5695, 5737,
-			5801, 5865, 5926, 5987, 6051, 5695, 5615, 6100, 6068, 6164, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 191, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			6207, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 6258, 6315, 191, 191, 191, 191, 6379, 6421, 3520, 6483, 191, 191, 191, 191,
-			6545, 6602, 6666, 6724, 6788, 6845, 6909, 6972, 7035, 7096, 7158, 7222, 191, 191, 191, 7277,
-			7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349,
-			7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365,
-			7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353,
-			7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341,
-			7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357,
-			7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345,
-			7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361,
-			7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349,
-			7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365,
-			7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353,
-			7361, 7341, 7349, 7357, 7365, 7345, 7353, 7361, 7341, 7349, 7357, 7365, 7345, 7353, 7417, 7474,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 7534, 7598, 191, 191,
-			191, 191, 191, 191, 7614, 191, 191, 7678, 7742, 7802, 191, 7846, 7910, 7972, 8021, 8084,
-			2300, 2330, 2359, 2390, 2422, 2422, 2422, 2423, 2422, 2422, 2422, 2423, 2455, 2455, 2455, 2455,
-			2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455,
-			2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455, 2455,
-			2455, 2455, 2455, 2455, 2487, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 3250, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 761, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 432, 191, 191, 191, 191, 191, 191, 191, 191, 1720, 191, 3163, 191,
-			191, 191, 2310, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 747, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 3171, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 3163,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 8147, 191,
-			191, 8163, 191, 8179, 191, 191, 191, 191, 191, 191, 191, 191, 1804, 8195, 191, 191,
-			191, 8202, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 1973, 747, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 8218, 191, 191, 191, 191, 191, 191, 191, 191, 191, 305, 432, 191, 191,
-			1975, 191, 191, 191, 191, 191, 191, 191, 405, 191, 191, 303, 6827, 191, 894, 8234,
-			405, 191, 191, 4250, 8250, 191, 191, 747, 405, 191, 304, 8266, 8282, 191, 191, 8295,
-			405, 191, 191, 308, 8311, 8327, 191, 191, 299, 8343, 191, 191, 191, 191, 191, 191,
-			3169, 191, 191, 296, 397, 747, 404, 191, 191, 2025, 1351, 767, 8358, 403, 191, 191,
-			191, 191, 191, 191, 191, 191, 306, 8364, 8380, 191, 191, 191, 191, 191, 311, 404,
-			747, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 296, 8396, 8411,
-			8420, 191, 191, 191, 311, 3305, 747, 8436, 191, 191, 191, 300, 400, 747, 191, 191,
-			191, 2220, 8452, 8465, 8481, 8496, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 299, 397, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 747, 191, 191,
-			191, 191, 8512, 8526, 747, 191, 191, 191, 191, 191, 191, 191, 720, 8542, 191, 4496,
-			191, 191, 8555, 8571, 873, 191, 191, 301, 8587, 8602, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 296,
-			1131, 8617, 747, 191, 8633, 191, 309, 1130, 401, 191, 191, 191, 191, 191, 191, 191,
-			8648, 8663, 747, 191, 191, 3819, 1002, 747, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 1974, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 593, 6601, 3163, 191, 191, 191, 191, 191, 191, 191, 8213,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 8671, 191, 191, 8685, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 8695, 191, 191, 191, 191, 191,
-			191, 191, 191, 8711, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			3191, 191, 191, 191, 191, 191, 191, 8727, 191, 191, 191, 191, 191, 747, 191, 191,
-			8743, 191, 191, 191, 8759, 3174, 747, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 4324, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 296, 310, 311, 311,
-			8775, 405, 191, 191, 191, 191, 8791, 406, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5675, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 5674, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 4844, 191, 191, 8807, 8819, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5671, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 8827,
-			404, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 311, 311, 807, 311, 401, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 8843, 311, 8856, 191, 1967, 191, 191, 191, 191, 191, 1033, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 886, 8872, 8872, 8872, 311, 311, 311, 3624,
-			311, 311, 395, 769, 8888, 300, 310, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 1131, 8904, 8918, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 401,
-			747, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 760, 191, 191, 191,
-			299, 8934, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 401, 191, 191, 191, 191, 191, 191, 8950, 8966, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 4621, 6601, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 5695, 5695, 8982, 5695, 5695,
-			5695, 5695, 5695, 5695, 8990, 9006, 9005, 9005, 9005, 5695, 8988, 4830, 191, 191, 191, 191,
-			191, 4830, 191, 191, 191, 9022, 9036, 9036, 9036, 9046, 9052, 9068, 5695, 5695, 8982, 8985,
-			9069, 8988, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 9085, 8072, 5695, 9096, 9110, 5695, 5695, 9123, 5695, 5695, 5695, 5695, 9139,
-			9154, 9164, 9171, 9186, 9200, 9216, 9230, 5695, 5695, 5695, 5695, 5021, 5676, 5599, 4845, 5018,
-			5695, 5695, 9242, 5695, 9258, 5695, 5695, 5695, 6168, 5695, 9270, 5695, 5695, 5695, 5695, 9281,
-			191, 191, 9297, 5695, 5695, 9198, 9313, 9319, 9332, 9345, 9345, 191, 191, 191, 191, 191,
-			191, 191, 9361, 191, 191, 191, 191, 191, 9377, 8982, 9035, 9393, 191, 191, 191, 9397,
-			9395, 191, 191, 9397, 191, 9413, 9069, 9036, 9036, 9036, 9036, 9429, 9162, 9195, 9444, 5695,
-			5695, 5695, 9194, 5695, 5695, 5695, 9459, 9157, 9474, 5695, 5695, 191, 191, 191, 191, 191,
-			9361, 9488, 9497, 8987, 5695, 9345, 8983, 9513, 8984, 8986, 9529, 191, 191, 191, 191, 191,
-			191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 747, 9036, 9036, 9036, 9036, 9036,
-			9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036,
-			9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9036, 9536, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695,
-			5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5695, 5669, 697, 191, 311, 311,
-			311, 311, 311, 311, 191, 191, 191, 191, 191, 191, 191, 191, 311, 311, 311, 311,
-			311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 191, 1077, 1109, 1139, 864,
-			1166, 1198, 1218, 1240, 1272, 1302, 1331, 1361, 1391, 1423, 1453, 1480, 864, 864, 1512, 864,
-			864, 864, 864, 864, 864, 1539, 1568, 864, 864, 864, 864, 864, 1572, 864, 864, 864,
-			864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 1601,
-			864, 1624, 202, 202, 202, 202, 202, 202, 202, 202, 1656, 202, 1688, 1704, 864, 864,
-			864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 1736,
-			1759, 864, 864, 864, 864, 1782, 864, 864, 864, 864, 864, 864, 864, 1798, 1819, 1847,
-			864, 1852, 864, 1884, 864, 864, 1916, 1938, 864, 864, 1957, 864, 1979, 864, 2011, 2043,
-			2075, 2107, 2139, 2171, 2203, 2204, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
-			202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
-			202, 202, 202, 202, 202, 202, 2236, 864, 864, 864, 864, 864, 864, 864, 864, 864,
-			864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864,
-			864, 864, 864, 864, 864, 864, 864, 2268,
-		},
-
-		data8: []uint8{
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 5, 6, 6, 7, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			8, 9, 10, 11, 12, 13, 11, 10, 14, 15, 11, 12, 16, 17, 16, 18,
-			19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 16, 16, 11, 11, 11, 9,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 14, 12, 15, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 14, 4, 20, 11, 3,
-			3, 3, 3, 3, 6, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 21,
-			14, 13, 12, 12, 12, 11, 11, 11, 11, 11, 10, 11, 4, 11, 11, 13,
-			12, 11, 11, 22, 11, 11, 11, 11, 11, 11, 10, 11, 11, 11, 14, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 22,
-			11, 11, 11, 22, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 22, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 21, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 21, 21, 21, 21, 21, 21, 21, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 16, 11, 11, 11, 3, 3, 3, 3, 3, 3,
-			3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 16, 4, 11, 11, 11, 11, 12, 11, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 11, 3,
-			3, 11, 3, 3, 9, 3, 11, 11, 11, 11, 11, 11, 11, 11, 23, 23,
-			23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23,
-			23, 23, 23, 23, 23, 23, 23, 23, 23, 11, 11, 11, 11, 23, 23, 23,
-			23, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 13, 13,
-			13, 16, 16, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			9, 3, 9, 9, 9, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 19, 19, 19, 19, 19, 19,
-			19, 19, 19, 19, 13, 19, 19, 11, 11, 11, 3, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 9,
-			11, 3, 3, 3, 3, 3, 3, 3, 11, 11, 3, 3, 3, 3, 3, 3,
-			11, 11, 3, 3, 11, 3, 3, 3, 3, 11, 11, 19, 19, 19, 19, 19,
-			19, 19, 19, 19, 19, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 11, 11, 11, 11, 16, 9, 11, 11,
-			11, 3, 12, 12, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 11, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 11, 3, 3, 3, 11, 3, 3, 3,
-			3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 3, 3, 3, 11, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 11, 3, 3, 3, 3, 3,
-			3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 4, 4,
-			19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 3, 11, 3, 3, 3, 3, 3, 11, 11, 3, 3,
-			11, 11, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 11, 11, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 11, 11, 13, 13, 11, 11, 11, 11, 11,
-			13, 11, 12, 11, 11, 3, 11, 3, 3, 3, 11, 11, 11, 11, 3, 3,
-			11, 11, 3, 3, 3, 11, 11, 11, 3, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 3, 3, 11, 11, 11, 3, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 11, 3, 3,
-			3, 11, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 11, 11, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 11, 12, 11, 11, 11, 11, 11, 11, 11,
-			11, 3, 3, 3, 3, 3, 3, 11, 11, 3, 3, 11, 11, 3, 3, 3,
-			11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 3, 3, 11, 11, 19, 19, 19, 19, 19, 19, 19, 19,
-			19, 19, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			3, 11, 11, 11, 3, 3, 3, 11, 3, 3, 3, 3, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 12, 11, 11, 11, 11, 11, 11, 3, 3,
-			3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 11, 3, 3, 3, 3,
-			3, 11, 3, 3, 3, 11, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11,
-			11, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			11, 11, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 11, 11, 11, 11,
-			11, 11, 11, 22, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 22,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 3, 11, 3, 3, 3, 3, 3, 11, 3,
-			3, 3, 11, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 11, 11, 19,
-			19, 19, 19, 19, 19, 19, 19, 19, 19, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 3, 3, 11, 3, 3, 3, 3, 3, 11, 3, 3, 3,
-			11, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 11, 11, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			13, 11, 11, 11, 11, 11, 11, 3, 3, 3, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 3, 11, 11, 11, 11, 3, 3, 3, 3, 3,
-			3, 11, 3, 11, 3, 3, 3, 3, 3, 3, 3, 3, 11, 11, 11, 11,
-			11, 11, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 11, 11, 3, 3,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 44, 43, 43, 44,
-			44, 44, 44, 44, 44, 44, 11, 11, 11, 11, 12, 43, 43, 43, 43, 43,
-			43, 43, 44, 44, 44, 44, 44, 44, 44, 44, 11, 19, 19, 19, 19, 19,
-			19, 19, 19, 19, 19, 4, 4, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 43, 43, 11, 43, 11,
-			43, 43, 43, 43, 43, 11, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 11, 43,
-			11, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 44, 43, 43, 44, 44,
-			44, 44, 44, 44, 44, 44, 44, 43, 11, 11, 43, 43, 43, 43, 43, 11,
-			43, 11, 44, 44, 44, 44, 44, 44, 11, 11, 19, 19, 19, 19, 19, 19,
-			19, 19, 19, 19, 11, 11, 43, 43, 43, 43, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 22, 22, 22, 22, 11, 22,
-			22, 21, 22, 22, 4, 21, 9, 9, 9, 9, 9, 21, 11, 9, 11, 11,
-			11, 3, 3, 11, 11, 11, 11, 11, 11, 19, 19, 19, 19, 19, 19, 19,
-			19, 19, 19, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 4, 3, 11,
-			3, 11, 3, 14, 20, 14, 20, 3, 3, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 3, 11, 11, 11, 11, 11,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 11, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			11, 4, 4, 11, 11, 11, 11, 11, 11, 3, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 22, 22, 4, 22, 11, 11, 11, 11, 11, 21, 21, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 44, 44,
-			44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
-			44, 44, 43, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 4, 4, 11,
-			11, 11, 11, 43, 43, 43, 43, 43, 43, 44, 44, 44, 44, 43, 43, 43,
-			43, 44, 44, 44, 43, 44, 44, 44, 43, 43, 44, 44, 44, 44, 44, 44,
-			44, 43, 43, 43, 44, 44, 44, 44, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 43,
-			44, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 44, 44, 44, 44, 43,
-			43, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-			25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-			25, 25, 25, 25, 25, 25, 25, 25, 25, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 3, 3, 3, 11, 4, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 4, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 14, 20, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 4, 4, 4, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 4, 4, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
-			44, 44, 44, 44, 4, 4, 27, 43, 4, 11, 4, 12, 43, 44, 11, 11,
-			19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			9, 9, 4, 4, 22, 11, 9, 9, 11, 3, 3, 3, 21, 3, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 19, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 11,
-			11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 11,
-			11, 11, 11, 9, 9, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 11, 11, 43,
-			43, 43, 43, 43, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 11, 11, 11, 11, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 11,
-			11, 11, 11, 11, 11, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 43,
-			11, 11, 11, 43, 43, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 11, 11, 11, 11, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 44, 44,
-			44, 44, 44, 44, 44, 44, 44, 44, 11, 44, 44, 44, 44, 44, 44, 44,
-			44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
-			44, 44, 44, 44, 44, 44, 11, 11, 3, 19, 19, 19, 19, 19, 19, 19,
-			19, 19, 19, 11, 11, 11, 11, 11, 11, 19, 19, 19, 19, 19, 19, 19,
-			19, 19, 19, 11, 11, 11, 11, 11, 11, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 11, 11, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 19,
-			19, 19, 19, 19, 19, 19, 19, 19, 19, 4, 4, 11, 4, 4, 4, 4,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 4, 4, 11, 3,
-			3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 11, 11, 19,
-			19, 19, 19, 19, 19, 19, 19, 19, 19, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 11, 11, 11, 4, 4, 4, 4, 4, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 11, 11, 11, 11, 11, 11, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 4, 4, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3,
-			11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 11, 11, 11, 11, 3, 11, 11, 11, 11, 11,
-			11, 3, 11, 11, 3, 3, 3, 11, 11, 11, 11, 11, 11, 4, 4, 4,
-			4, 4, 4, 4, 21, 4, 4, 4, 28, 3, 29, 3, 3, 30, 21, 4,
-			4, 31, 11, 11, 11, 10, 10, 14, 10, 10, 10, 14, 10, 11, 11, 11,
-			11, 32, 32, 32, 4, 6, 6, 3, 3, 3, 3, 3, 21, 13, 13, 13,
-			13, 13, 13, 13, 13, 11, 10, 10, 11, 27, 27, 11, 11, 11, 11, 16,
-			14, 20, 27, 27, 27, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 4, 11, 4, 4, 4, 4, 11, 4, 4, 4, 33, 11, 11, 11, 11,
-			11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 14, 20, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 12, 12, 12, 12, 12,
-			12, 12, 13, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 13, 12, 12, 12, 12, 13, 12, 12, 13, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 13, 11, 11, 11, 11, 11, 13,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 12, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 12, 12, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 32, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 14, 20, 14, 20, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 34, 34, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 35, 20, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 34, 34, 34, 34, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 34,
-			34, 11, 11, 34, 11, 34, 34, 34, 36, 34, 34, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 34, 34, 34, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 34,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 34, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 34, 34, 34, 34, 34, 34, 34, 34, 34, 11, 11, 11,
-			11, 34, 11, 34, 34, 34, 11, 34, 34, 11, 11, 11, 34, 34, 11, 11,
-			34, 11, 11, 34, 34, 34, 11, 11, 11, 11, 11, 11, 11, 11, 34, 11,
-			11, 11, 11, 11, 11, 34, 34, 34, 34, 34, 11, 34, 34, 36, 34, 11,
-			11, 34, 34, 34, 34, 34, 11, 11, 11, 34, 34, 36, 36, 36, 36, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 10, 10, 10, 10, 10, 10, 11, 9, 9, 34, 11, 11, 11, 14, 20,
-			14, 20, 14, 20, 14, 20, 14, 20, 14, 20, 14, 20, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 14, 20, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 14, 20, 14, 20, 14, 20, 14, 20, 14,
-			20, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 14, 20, 14, 20, 14, 20, 14, 20, 14, 20, 14, 20, 14, 20, 14,
-			20, 14, 20, 14, 20, 14, 20, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 14, 20,
-			14, 20, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 14, 20, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 9, 4, 4,
-			4, 11, 9, 4, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 4, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 3, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
-			10, 10, 4, 4, 4, 4, 4, 4, 4, 4, 11, 4, 14, 4, 11, 11,
-			10, 10, 11, 11, 10, 10, 14, 20, 14, 20, 14, 20, 14, 20, 4, 4,
-			4, 4, 9, 11, 4, 4, 11, 4, 4, 11, 11, 11, 11, 11, 31, 31,
-			4, 4, 4, 11, 4, 4, 14, 4, 4, 4, 4, 4, 4, 4, 4, 11,
-			4, 11, 4, 4, 11, 11, 11, 9, 9, 14, 20, 14, 20, 14, 20, 14,
-			20, 4, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 11, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 11, 11, 11, 11, 4, 20, 20, 34, 34, 27, 34,
-			34, 35, 20, 35, 20, 35, 20, 35, 20, 35, 20, 34, 34, 35, 20, 35,
-			20, 35, 20, 35, 20, 27, 35, 20, 20, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 3, 3, 3, 3, 3, 3, 34, 34, 34, 34, 34, 3, 34,
-			34, 34, 34, 34, 27, 27, 34, 34, 34, 11, 27, 34, 27, 34, 27, 34,
-			27, 34, 27, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 27, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 27, 34, 27, 34, 27, 34, 34,
-			34, 34, 34, 34, 27, 34, 34, 34, 34, 34, 34, 27, 27, 11, 11, 3,
-			3, 27, 27, 27, 27, 34, 27, 27, 34, 27, 34, 27, 34, 27, 34, 27,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 27, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 27, 34, 27, 34, 27, 34, 34, 34, 34, 34,
-			34, 27, 34, 34, 34, 34, 34, 34, 27, 27, 34, 34, 34, 34, 27, 27,
-			27, 27, 34, 11, 11, 11, 11, 11, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 11, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
-			27, 27, 27, 27, 34, 34, 34, 34, 34, 34, 34, 34, 11, 11, 11, 11,
-			11, 11, 11, 11, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 27, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 11,
-			11, 11, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 4, 4, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 4, 9, 4, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 19, 19, 19, 19, 19,
-			19, 19, 19, 19, 19, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 3, 3, 3, 3, 11, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 3, 3, 11, 4, 4, 4, 4, 4, 11, 11, 11, 11, 11,
-			11, 11, 11, 3, 11, 11, 11, 3, 11, 11, 11, 11, 3, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 3, 3, 3, 3, 3, 11, 11, 11, 11, 3, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 13, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 22, 22,
-			9, 9, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11,
-			11, 11, 4, 4, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 11, 11,
-			11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			22, 11, 11, 3, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3,
-			3, 3, 4, 4, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 11, 11, 11, 3, 3, 3,
-			3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 11, 11, 11,
-			11, 11, 11, 4, 4, 4, 11, 11, 11, 11, 11, 11, 19, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 11, 11, 11, 11, 11, 11, 43, 43, 43, 43,
-			43, 44, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 19, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 43, 43, 43, 43, 43, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 11, 11, 11, 11,
-			11, 11, 11, 11, 3, 3, 11, 11, 19, 19, 19, 19, 19, 19, 19, 19,
-			19, 19, 11, 11, 11, 4, 4, 4, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 44, 44, 44, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-			43, 43, 43, 43, 43, 43, 44, 43, 44, 44, 44, 43, 43, 44, 44, 43,
-			43, 43, 43, 43, 44, 44, 43, 44, 43, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 43, 43, 43, 43, 43, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 3, 3, 3, 3, 3, 4, 4, 11, 11, 11, 3, 3, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 3, 11, 11, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 11, 11, 11, 11, 11, 11, 37, 38, 38,
-			38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
-			38, 38, 38, 38, 38, 38, 38, 38, 38, 37, 38, 38, 38, 38, 38, 38,
-			38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
-			38, 38, 38, 38, 38, 37, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
-			38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
-			38, 37, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
-			38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 25, 25, 25, 25, 25, 25, 25,
-			25, 25, 25, 25, 25, 25, 25, 25, 25, 11, 11, 11, 11, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 23, 3, 23, 23, 23,
-			23, 23, 23, 23, 23, 23, 23, 11, 23, 23, 23, 23, 23, 23, 23, 23,
-			23, 23, 23, 23, 23, 11, 23, 23, 23, 23, 23, 11, 23, 11, 23, 23,
-			11, 23, 23, 11, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 20, 14, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 13, 11, 11, 11, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 20,
-			20, 16, 16, 9, 9, 35, 20, 32, 11, 11, 11, 11, 11, 11, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 34, 34,
-			34, 34, 34, 35, 20, 35, 20, 35, 20, 35, 20, 35, 20, 35, 20, 34,
-			34, 35, 20, 34, 34, 34, 34, 34, 34, 34, 20, 34, 20, 11, 27, 27,
-			9, 9, 34, 35, 20, 35, 20, 35, 20, 34, 34, 34, 34, 34, 34, 34,
-			34, 11, 34, 12, 13, 34, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 33, 11, 9, 34, 34, 12, 13, 34, 34, 35, 20,
-			34, 34, 20, 34, 20, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			27, 27, 34, 34, 34, 9, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 35, 34, 20, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 35,
-			34, 20, 34, 35, 20, 20, 35, 20, 20, 27, 34, 27, 27, 27, 27, 27,
-			27, 27, 27, 27, 27, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 27, 27, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 34, 11, 11, 34, 34, 34, 34, 34, 34, 11, 11, 34, 34,
-			34, 34, 34, 34, 11, 11, 34, 34, 34, 34, 34, 34, 11, 11, 34, 34,
-			34, 11, 11, 11, 13, 12, 34, 34, 34, 12, 12, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3,
-			39, 11, 11, 11, 3, 3, 3, 11, 3, 3, 11, 11, 11, 11, 11, 3,
-			3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 11, 11,
-			11, 11, 3, 4, 4, 4, 4, 4, 4, 4, 4, 11, 11, 11, 11, 11,
-			11, 11, 11, 4, 4, 4, 4, 4, 4, 32, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 4, 4, 4, 4, 4, 4, 4, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 3, 3, 4, 11, 11, 3, 11, 11, 3, 3, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 4, 4, 3, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 11,
-			19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 4, 4, 4, 4, 11, 3,
-			3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 11, 22, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 3, 11, 11, 11, 11, 4, 4, 11, 4,
-			3, 3, 3, 3, 11, 3, 3, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-			19, 11, 22, 11, 4, 4, 4, 3, 3, 3, 3, 3, 3, 3, 3, 4,
-			4, 11, 4, 4, 11, 3, 11, 11, 3, 3, 11, 11, 3, 3, 3, 3,
-			3, 3, 3, 11, 11, 11, 11, 4, 4, 4, 4, 11, 19, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 4, 4, 11, 11, 3, 11, 3, 3, 3, 3,
-			3, 3, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 22, 4, 4, 9,
-			9, 11, 11, 11, 4, 4, 4, 4, 4, 4, 4, 4, 11, 11, 11, 11,
-			3, 3, 11, 11, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22,
-			22, 11, 11, 11, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 11,
-			11, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 11, 11, 11,
-			11, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 43, 43, 4, 4, 4,
-			43, 43, 43, 43, 43, 43, 43, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			3, 3, 3, 3, 3, 3, 11, 3, 3, 11, 11, 3, 3, 3, 3, 11,
-			3, 3, 4, 4, 4, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 11,
-			22, 11, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			3, 3, 3, 3, 3, 11, 3, 3, 3, 3, 22, 11, 4, 4, 4, 4,
-			22, 11, 3, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 4, 4, 4, 11, 22, 22, 4, 4, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 4, 4, 4, 4, 4, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 22, 9, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 11,
-			11, 11, 3, 11, 3, 3, 11, 3, 3, 3, 3, 3, 3, 11, 3, 11,
-			11, 11, 11, 11, 11, 11, 11, 14, 14, 14, 20, 20, 20, 11, 11, 20,
-			11, 11, 11, 14, 20, 14, 20, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			14, 20, 20, 11, 11, 11, 11, 21, 21, 21, 21, 21, 21, 21, 14, 20,
-			11, 11, 11, 11, 11, 11, 11, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-			19, 11, 11, 11, 11, 4, 4, 3, 3, 3, 3, 3, 4, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 4, 4,
-			4, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 11,
-			11, 11, 11, 11, 11, 11, 3, 27, 27, 27, 27, 21, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 27, 27, 27, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 27, 27, 27, 27, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 4, 11, 11, 11, 11, 11,
-			3, 3, 3, 3, 3, 11, 11, 11, 3, 3, 3, 11, 11, 3, 3, 3,
-			3, 3, 3, 3, 11, 11, 11, 11, 19, 19, 19, 19, 19, 19, 19, 19,
-			19, 19, 19, 19, 19, 19, 19, 19, 11, 11, 11, 11, 3, 11, 11, 4,
-			4, 4, 4, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 11, 11, 3, 3, 3, 3, 3, 11, 3, 3, 11, 3, 3, 3, 3,
-			3, 11, 11, 11, 11, 11, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-			11, 11, 11, 11, 11, 12, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3,
-			3, 11, 11, 11, 11, 11, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-			11, 11, 11, 11, 14, 14, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 34, 34,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 40, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 34, 40, 40, 40, 40,
-			40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 41, 41, 41, 41,
-			41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 34, 34, 34, 40,
-			40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 34, 34, 34,
-			34, 34, 36, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 11, 11, 34,
-			34, 34, 34, 34, 11, 34, 34, 34, 36, 36, 36, 34, 34, 36, 34, 34,
-			36, 36, 36, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 42, 42,
-			42, 42, 42, 34, 34, 36, 36, 34, 34, 36, 36, 36, 36, 36, 36, 36,
-			36, 36, 36, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 34, 34, 34, 36,
-			34, 34, 34, 36, 36, 36, 34, 36, 36, 36, 34, 34, 34, 34, 34, 34,
-			34, 36, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34,
-			11, 34, 11, 34, 11, 34, 34, 34, 34, 34, 36, 34, 34, 34, 34, 11,
-			11, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 36, 36,
-			34, 34, 34, 34, 36, 34, 34, 34, 34, 34, 36, 34, 34, 34, 34, 36,
-			36, 34, 34, 34, 34, 34, 34, 34, 34, 34, 11, 11, 11, 11, 11, 11,
-			34, 34, 34, 34, 34, 34, 36, 36, 36, 34, 34, 34, 36, 36, 36, 36,
-			36, 11, 11, 11, 11, 11, 11, 10, 10, 10, 27, 27, 27, 11, 11, 11,
-			11, 34, 34, 34, 34, 36, 36, 36, 34, 34, 34, 34, 34, 34, 34, 34,
-			34, 34, 34, 36, 34, 34, 34, 34, 34, 34, 34, 34, 40, 40, 40, 40,
-			40, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 40, 40,
-			40, 11, 11, 11, 11, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
-			40, 11, 11, 11, 11, 11, 34, 34, 34, 34, 40, 40, 40, 40, 40, 40,
-			40, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 40, 40, 40,
-			40, 40, 40, 40, 40, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 40, 40, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 36, 34, 34, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 34, 34,
-			36, 36, 36, 34, 34, 34, 34, 34, 36, 36, 34, 36, 36, 34, 36, 34,
-			34, 34, 34, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36,
-			34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 40, 40,
-			40, 34, 34, 34, 34, 34, 40, 40, 40, 34, 34, 34, 36, 36, 36, 40,
-			40, 40, 40, 40, 40, 40, 40, 40, 40, 36, 36, 36, 36, 36, 36, 36,
-			40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 11, 11,
-			11, 11, 11, 0,
-		},
-
-		nullValue: 11,
-	},
-}
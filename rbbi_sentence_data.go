@@ -1,1303 +0,0 @@
-// This file is auto-generated by maketable.py
-
-package rbbi
-
-// Lookup tables for sentence breaks.
-var rbbiSentenceData rbbiData = rbbiData{
-	categoryCount: 17,
-
-	forwardTable: rbbiStateTable{
-		stateCount:           17,
-		rowLength:            20,
-		dictCategoriesStart:  17,
-		lookaheadResultsSize: 0,
-
-		lookaheadHardBreak: false,
-		bofRequired:        true,
-		valueWidth:         rbbiStateTableValueWidth8,
-
-		rows: []rbbiStateTableRow{
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 3, 4, 5, 5, 3, 6, 7, 5, 5, 8, 5, 9, 9, 3, 5,
-					5,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 3, 0, 5, 5, 3, 6, 7, 5, 5, 8, 5, 9, 9, 3, 5,
-					5,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 3, 0, 5, 5, 3, 6, 7, 5, 5, 8, 5, 9, 9, 3, 5,
-					5,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 10, 11, 12, 7, 7, 5, 8, 0, 0, 0, 11, 7,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 14, 11, 12, 7, 15, 5, 8, 5, 0, 9, 11, 8,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 3, 0, 5, 5, 3, 6, 7, 5, 5, 16, 5, 9, 9, 3, 9,
-					5,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 10, 11, 12, 7, 0, 5, 8, 0, 0, 0, 11, 10,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 11, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 13, 0, 0, 0, 13, 13, 0, 13, 0, 9, 0, 13,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 14, 11, 12, 7, 13, 5, 8, 13, 0, 9, 11, 14,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 14, 11, 12, 7, 15, 5, 8, 13, 0, 9, 11, 15,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 14, 11, 12, 7, 15, 5, 8, 5, 9, 9, 11, 16,
-					0,
-				},
-			},
-		},
-	},
-
-	reverseTable: rbbiStateTable{
-		stateCount:           17,
-		rowLength:            20,
-		dictCategoriesStart:  17,
-		lookaheadResultsSize: 0,
-
-		lookaheadHardBreak: false,
-		bofRequired:        true,
-		valueWidth:         rbbiStateTableValueWidth8,
-
-		rows: []rbbiStateTableRow{
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 3, 4, 5, 5, 3, 6, 7, 5, 5, 8, 5, 9, 9, 3, 5,
-					5,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 3, 0, 5, 5, 3, 6, 7, 5, 5, 8, 5, 9, 9, 3, 5,
-					5,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 3, 0, 5, 5, 3, 6, 7, 5, 5, 8, 5, 9, 9, 3, 5,
-					5,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 10, 11, 12, 7, 7, 5, 8, 0, 0, 0, 11, 7,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 14, 11, 12, 7, 15, 5, 8, 5, 0, 9, 11, 8,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 3, 0, 5, 5, 3, 6, 7, 5, 5, 16, 5, 9, 9, 3, 9,
-					5,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 10, 11, 12, 7, 0, 5, 8, 0, 0, 0, 11, 10,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 11, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 13, 0, 0, 0, 13, 13, 0, 13, 0, 9, 0, 13,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 14, 11, 12, 7, 13, 5, 8, 13, 0, 9, 11, 14,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 14, 11, 12, 7, 15, 5, 8, 13, 0, 9, 11, 15,
-					0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 13, 14, 11, 12, 7, 15, 5, 8, 5, 9, 9, 11, 16,
-					0,
-				},
-			},
-		},
-	},
-
-	trie: ucpTrie{
-		trieType:           ucpTrieTypeFast,
-		valueWidth:         ucpTrieValueWidth8,
-		dataLength:         11760,
-		index3NullOffset:   864,
-		dataNullOffset:     6722,
-		highStart:          918016,
-		shifted12HighStart: 225,
-		nullValueOffset:    6722,
-
-		index: []uint16{
-			0, 64, 126, 190, 254, 301, 364, 428, 484, 547, 591, 655, 719, 735, 799, 848,
-			909, 957, 1019, 1083, 1099, 1148, 1203, 1265, 1329, 1382, 1431, 1475, 1539, 1592, 1618, 1682,
-			1746, 1810, 1866, 1920, 1980, 2042, 2105, 2167, 2230, 2292, 2355, 2417, 2481, 2543, 2605, 2667,
-			2731, 2793, 2857, 2919, 2983, 3045, 3109, 3173, 3236, 3300, 3363, 3427, 3491, 3555, 3614, 3675,
-			3739, 3803, 3865, 3923, 1431, 1431, 1431, 1431, 1431, 3973, 4028, 4090, 4137, 4174, 4238, 4270,
-			4333, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 4352, 4416, 4448, 4512, 4576, 4640, 4692,
-			4754, 4786, 4850, 4898, 4962, 5022, 5086, 5140, 5204, 5247, 5311, 5360, 5424, 5483, 5547, 5605,
-			5669, 5733, 5797, 5861, 5925, 5925, 5925, 719, 5989, 5989, 6031, 5989, 6094, 6158, 6222, 6285,
-			6349, 6411, 6475, 6523, 6585, 6649, 6713, 6722, 6722, 6722, 6722, 6722, 6778, 6722, 6722, 6722,
-			6722, 6722, 6821, 6875, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6917, 6722, 6976,
-			6722, 6722, 6722, 6722, 6722, 6722, 7037, 7077, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			909, 7141, 5989, 7204, 7268, 7316, 7380, 7412, 7476, 7538, 6722, 6722, 6722, 6722, 6722, 6722,
-			7602, 4333, 7666, 7699, 7763, 1431, 7812, 3691, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 6722, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 7863, 7911, 1431, 1431, 1431, 1431, 7960, 8024, 8088, 8120, 8176, 8226, 8288, 8348,
-			8410, 8474, 8538, 8596, 8660, 8717, 8781, 8844, 8908, 8972, 9034, 9098, 9161, 9209, 5925, 9273,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 9337, 9394,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 1431, 1431, 1431, 1431, 1431, 9458, 1431, 7850, 9522, 9586, 9600, 7908,
-			1431, 1431, 1431, 1431, 9664, 8458, 9728, 9784, 9848, 9907, 1431, 9961, 10025, 10088, 10126, 10189,
-			2519, 2549, 2578, 2609, 2641, 2641, 2654, 2683, 2715, 2725, 2725, 2725, 2725, 2725, 2725, 2725,
-			2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725,
-			2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725, 2725,
-			2725, 2725, 2725, 2725, 2757, 1862, 1431, 2765, 10253, 1294, 1294, 6722, 6722, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 662, 6722, 6722, 6722, 6722, 1431, 1431, 1431, 668, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 10269, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 1431, 1295, 1431,
-			1431, 1431, 701, 3681, 6722, 1431, 1431, 3726, 1431, 662, 1431, 1431, 10285, 1431, 1294, 1431,
-			1431, 5126, 1311, 6722, 6722, 65, 65, 835, 5925, 5925, 1431, 1431, 1431, 1431, 1294, 2455,
-			65, 65, 6309, 5925, 6492, 1431, 1431, 665, 1431, 1431, 1431, 669, 202, 202, 10301, 9234,
-			10315, 6722, 6722, 6722, 6722, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 666, 1431, 667, 665, 6722, 10331, 5925, 5925,
-			10336, 6722, 6722, 6722, 6722, 10352, 1431, 1431, 10362, 1431, 667, 1431, 666, 1431, 1293, 6722,
-			6722, 6722, 6722, 1431, 10377, 1431, 667, 1431, 663, 6722, 6722, 6722, 6722, 1431, 1431, 1431,
-			10393, 6722, 6722, 6722, 6722, 10408, 2365, 1431, 10424, 6722, 10440, 1431, 1295, 1431, 1295, 6722,
-			6722, 2138, 1431, 4589, 6722, 1431, 1431, 1431, 667, 1431, 667, 1431, 670, 1431, 671, 6722,
-			6722, 6722, 6722, 6722, 6722, 1431, 1431, 1431, 1431, 664, 6722, 6722, 6722, 65, 65, 65,
-			10456, 5925, 5925, 5925, 6501, 1431, 1431, 4526, 2455, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 1431, 1431, 10472, 671, 6722, 6722, 6722, 6722, 1431, 1295, 3484,
-			1431, 1387, 10484, 6722, 1431, 10500, 6722, 6722, 1431, 668, 6722, 1431, 666, 1895, 1431, 1431,
-			1385, 10516, 6722, 1676, 10532, 1895, 1431, 1431, 10547, 10561, 1431, 664, 2455, 1895, 1431, 1386,
-			3310, 10576, 1431, 1431, 10592, 1895, 1431, 1431, 1390, 10608, 10624, 6722, 6722, 1431, 2371, 1381,
-			10640, 6722, 6722, 6722, 6722, 10656, 1860, 10671, 1431, 1431, 1378, 3664, 2455, 10687, 2121, 1865,
-			10702, 2543, 10718, 10732, 5370, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 1431, 1431, 1431,
-			1388, 10748, 10764, 671, 6722, 1431, 1431, 1431, 719, 10780, 2455, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 1431, 1431, 1378, 10796, 10811, 10820, 6722, 6722, 1431, 1431, 1431,
-			719, 10836, 2455, 6722, 6722, 1431, 1431, 1382, 1659, 2455, 6722, 6722, 6722, 1431, 4190, 3663,
-			10852, 666, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 1431, 1431, 1381,
-			3664, 6722, 6722, 6722, 6722, 6722, 6722, 65, 65, 5925, 5925, 2455, 2434, 10868, 10880, 1431,
-			10896, 10910, 2455, 6722, 6722, 6722, 6722, 9496, 1431, 1431, 10926, 10941, 6722, 10957, 1431, 1431,
-			10970, 10985, 11001, 1431, 1431, 1383, 11017, 6722, 1431, 1431, 1431, 1431, 664, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 1865, 1431, 1378,
-			3631, 11033, 2455, 6722, 1279, 1431, 717, 3630, 5368, 6722, 6722, 6722, 6722, 2397, 1431, 1431,
-			11049, 11064, 2455, 11080, 1431, 5217, 11096, 2455, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 1431, 11112, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 701, 6722, 6722, 6722, 6722, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 663, 6722, 6722, 6722, 6722, 6722, 6722, 1431, 1431, 1431, 1431, 1431, 1431, 1293, 6722,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 669, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 1431, 1431, 1431, 1431, 1431, 1431, 701, 1431, 1431, 1293,
-			5366, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 1431, 1431, 1431,
-			1431, 666, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 1431, 1431, 1431,
-			664, 1431, 1293, 11128, 1431, 1431, 1431, 1431, 1293, 2455, 1431, 1294, 11144, 1431, 1431, 1431,
-			10516, 11160, 2455, 1278, 1845, 1431, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 65, 65, 5925,
-			5925, 6722, 11168, 6722, 6722, 6722, 6722, 6722, 6722, 1431, 1431, 1431, 1431, 2652, 1392, 719,
-			719, 11184, 1895, 6722, 6722, 6722, 6722, 11200, 5373, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 665, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 667, 6722, 6722, 664, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 2267, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 670, 6722, 6722, 670,
-			11212, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			661, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 1431, 1431, 1431, 1431, 1431, 1431, 662, 1295, 664, 11228, 5371, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 719, 719, 1589, 719, 5368, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 11244, 719, 11257, 6722,
-			11269, 6722, 6722, 6722, 6722, 6722, 11283, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 65, 947, 5925, 11299, 943, 9231, 6094, 65, 219, 11315, 11331, 11345, 9232, 65,
-			947, 5925, 11358, 11371, 5925, 11385, 11401, 11416, 11420, 65, 215, 5925, 65, 947, 5925, 11299,
-			943, 5925, 6094, 65, 219, 11420, 65, 215, 5925, 65, 947, 5925, 11436, 65, 11416, 234,
-			907, 11444, 5925, 11456, 65, 11412, 230, 11462, 198, 5925, 236, 65, 11469, 5925, 11482, 11496,
-			11496, 11496, 719, 719, 719, 4999, 719, 719, 3662, 2685, 11512, 708, 718, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 601, 230, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 3631, 11528, 11542, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 1431, 1431, 1295, 11558, 11574, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 1431, 11590, 6722, 1431, 1431, 1381, 2455, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 11606, 1293, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 668, 5368, 6722, 6722, 65, 65, 219, 5925, 11622, 2455, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 2768, 1431, 11637, 11650, 11664, 11680, 11694,
-			11702, 1864, 661, 11717, 661, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 65, 11733, 65, 11733, 65, 11733, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 11743, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 2455, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 6722, 6722, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 664, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1294, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 671, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 701, 6722, 1431, 1294, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431,
-			1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 1431, 662, 6722, 6722, 6722, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 2689, 6722, 719, 719, 719, 719, 719, 719, 6722,
-			6722, 6722, 6722, 6722, 6722, 6722, 6722, 719, 719, 719, 719, 719, 719, 719, 719, 719,
-			719, 719, 719, 719, 719, 719, 6722, 1077, 1109, 1141, 1173, 1205, 1237, 1269, 1293, 1325,
-			1357, 1389, 1421, 1453, 1485, 1517, 1544, 208, 1576, 1608, 864, 864, 864, 864, 1629, 208,
-			208, 1661, 864, 864, 864, 864, 864, 208, 1693, 864, 864, 864, 864, 864, 864, 864,
-			864, 864, 864, 864, 864, 864, 864, 864, 864, 208, 1725, 864, 1753, 208, 208, 208,
-			208, 208, 208, 208, 208, 1785, 208, 208, 1817, 864, 864, 864, 864, 864, 864, 864,
-			864, 864, 864, 864, 864, 864, 864, 864, 864, 1834, 1866, 1889, 864, 864, 864, 864,
-			1921, 864, 864, 864, 864, 864, 864, 864, 1937, 1958, 1986, 2018, 2050, 864, 2082, 864,
-			2098, 2130, 2153, 864, 2169, 2201, 864, 864, 2233, 2246, 864, 864, 2271, 864, 2279, 864,
-			864, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208,
-			208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208,
-			208, 2311, 208, 208, 208, 208, 208, 208, 208, 2327, 2358, 208, 208, 208, 208, 208,
-			208, 208, 2380, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208,
-			2391, 864, 864, 864, 864, 864, 864, 208, 2423, 864, 864, 208, 208, 208, 208, 208,
-			208, 208, 208, 208, 2455, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864,
-			864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864,
-			864, 864, 864, 864, 864, 2487,
-		},
-
-		data8: []uint8{
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 5, 4, 4, 6, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			4, 7, 8, 3, 3, 3, 3, 8, 8, 8, 3, 3, 9, 9, 10, 3,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 9, 3, 3, 3, 3, 7,
-			3, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 8, 3, 8, 3, 3,
-			3, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 8, 3, 8, 3, 3,
-			3, 3, 3, 14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 13, 8, 3, 15, 3, 3, 3, 3,
-			3, 3, 3, 13, 3, 3, 3, 3, 13, 8, 3, 3, 3, 3, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 3, 12, 12, 12, 12, 12, 12, 12, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 3, 13, 13, 13, 13, 13, 13, 13, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 12, 13, 12, 13, 12, 13, 13, 12, 12, 13,
-			12, 13, 12, 12, 13, 12, 12, 12, 13, 13, 12, 12, 12, 12, 13, 12,
-			12, 13, 12, 12, 12, 13, 13, 13, 12, 12, 13, 12, 12, 13, 12, 13,
-			12, 13, 12, 12, 13, 12, 13, 13, 12, 13, 12, 12, 13, 12, 12, 12,
-			13, 12, 13, 12, 12, 13, 13, 16, 12, 13, 13, 13, 16, 16, 16, 16,
-			12, 12, 13, 12, 12, 13, 12, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 13, 12, 12, 13,
-			12, 13, 12, 12, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 13, 13, 13, 13, 13, 13, 12, 12,
-			13, 12, 12, 13, 12, 13, 12, 12, 12, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 16, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 16, 16, 16, 16, 16, 16, 16, 13,
-			13, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 13,
-			13, 13, 13, 13, 3, 3, 3, 3, 3, 3, 3, 16, 3, 16, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 12,
-			13, 12, 13, 16, 3, 12, 13, 3, 3, 13, 13, 13, 13, 3, 12, 3,
-			3, 3, 3, 3, 3, 12, 3, 12, 12, 12, 3, 12, 3, 12, 12, 13,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 3, 12, 12, 12, 12, 12, 12, 12, 12, 12, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 12,
-			13, 13, 12, 12, 12, 13, 13, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			13, 13, 13, 13, 12, 13, 3, 12, 13, 12, 12, 13, 13, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 3, 15, 15,
-			15, 15, 15, 15, 15, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 3, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 3, 3, 16, 3, 3, 3, 9, 3, 3, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 7, 3, 3, 3,
-			3, 3, 3, 3, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 3, 15, 15, 3, 15, 15, 3, 15, 3, 3, 3, 3, 3, 3, 3,
-			3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 3,
-			16, 16, 16, 16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 15, 15, 15, 15, 15, 15, 3, 3, 3, 3, 3, 3, 9, 9, 3,
-			3, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 15, 7, 7,
-			7, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			3, 11, 11, 3, 16, 16, 15, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 7, 16, 15, 15, 15, 15, 15, 15, 15,
-			15, 3, 15, 15, 15, 15, 15, 15, 16, 16, 15, 15, 3, 15, 15, 15,
-			15, 16, 16, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 16, 16, 16,
-			3, 3, 16, 7, 7, 7, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 15, 16, 15, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 16, 16, 3, 3, 9, 7, 16, 3, 3, 15,
-			3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 15, 16, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 16, 15, 15, 15, 16, 15, 15, 15, 15, 15,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 7, 3, 3, 3, 7,
-			7, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 3, 3,
-			3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3,
-			3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 3, 16, 16, 16, 16, 16, 16, 3, 15, 15, 3, 3, 3, 3,
-			3, 3, 15, 15, 15, 15, 15, 15, 15, 15, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 15, 15, 15, 16, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 16, 15, 15, 15, 15, 15,
-			15, 15, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 7, 7,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 3, 16, 16,
-			16, 16, 16, 16, 16, 16, 3, 3, 16, 16, 3, 3, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 3, 16, 16, 16, 16, 16, 16, 16, 3, 16, 3, 3, 3, 16,
-			16, 16, 16, 3, 3, 15, 16, 15, 15, 15, 15, 15, 3, 3, 15, 15,
-			3, 3, 15, 15, 15, 16, 3, 3, 3, 3, 3, 3, 3, 3, 15, 3,
-			3, 3, 3, 16, 16, 3, 16, 16, 16, 15, 15, 3, 3, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 16, 16, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 16, 3, 15, 3, 15, 15, 15, 3, 16, 16, 16, 16, 16,
-			16, 3, 3, 3, 3, 16, 16, 3, 3, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3,
-			16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 3, 16, 16, 3, 16, 16,
-			3, 3, 15, 3, 15, 15, 15, 3, 3, 3, 3, 15, 15, 3, 3, 15,
-			15, 15, 3, 3, 3, 15, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16,
-			16, 3, 16, 3, 3, 3, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 15, 15, 16, 16, 16, 15, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 15, 15, 15, 3, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 3, 16, 16, 16, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 16,
-			16, 16, 16, 16, 3, 16, 16, 3, 16, 16, 16, 16, 16, 3, 3, 15,
-			16, 15, 15, 15, 15, 15, 15, 3, 15, 15, 15, 3, 15, 15, 15, 3,
-			3, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 16, 16, 15, 15, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 15, 15, 15, 15, 15,
-			15, 3, 15, 15, 15, 3, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3,
-			16, 16, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 16, 16, 16,
-			16, 16, 3, 16, 16, 3, 16, 16, 16, 16, 16, 3, 3, 15, 16, 15,
-			15, 15, 15, 15, 3, 3, 15, 15, 3, 3, 15, 15, 15, 3, 3, 3,
-			3, 3, 3, 3, 15, 15, 15, 3, 3, 3, 3, 16, 16, 3, 16, 16,
-			16, 15, 15, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3,
-			16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 15,
-			16, 3, 16, 16, 16, 16, 16, 16, 3, 3, 3, 16, 16, 16, 3, 16,
-			16, 16, 16, 3, 3, 3, 16, 16, 3, 16, 3, 16, 16, 3, 3, 3,
-			16, 16, 3, 3, 3, 16, 16, 16, 3, 3, 3, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 3, 15, 15, 15, 3, 3,
-			3, 15, 15, 15, 3, 15, 15, 15, 15, 3, 3, 16, 3, 3, 3, 3,
-			3, 3, 15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 15, 15, 15, 15, 15,
-			16, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 16, 3, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 3, 3, 15, 16, 15, 15, 15, 15, 15, 3, 15,
-			15, 15, 3, 15, 15, 15, 15, 3, 3, 3, 3, 3, 3, 3, 15, 15,
-			3, 16, 16, 16, 3, 3, 16, 3, 3, 16, 16, 15, 15, 3, 3, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 15, 15, 15, 3, 16, 16,
-			16, 16, 16, 16, 16, 16, 3, 16, 16, 16, 3, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16,
-			16, 16, 16, 3, 3, 15, 16, 15, 15, 15, 15, 15, 3, 15, 15, 15,
-			3, 15, 15, 15, 15, 3, 3, 3, 3, 3, 3, 3, 15, 15, 3, 3,
-			3, 3, 3, 3, 16, 16, 3, 16, 16, 15, 15, 3, 3, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 3, 16, 16, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 15, 15, 15, 15, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 3, 16, 16, 16, 3, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 15, 15, 16, 15, 15, 15, 15, 15, 3, 15, 15, 15, 3, 15,
-			15, 15, 15, 16, 3, 3, 3, 3, 3, 16, 16, 16, 15, 3, 3, 3,
-			3, 3, 3, 3, 16, 16, 16, 15, 15, 3, 3, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16,
-			16, 16, 16, 16, 16, 3, 15, 15, 15, 3, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 3, 16, 3, 3, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 15,
-			3, 3, 3, 3, 15, 15, 15, 15, 15, 15, 3, 15, 3, 15, 15, 15,
-			15, 15, 15, 15, 15, 3, 3, 3, 3, 3, 3, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 3, 3, 15, 15, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 15, 16, 16, 15, 15, 15, 15, 15, 15, 15, 3,
-			3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 15, 15,
-			15, 15, 15, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 16, 16, 3, 16, 3, 16, 16, 16, 16, 16, 3, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 3, 16, 3, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 15, 16, 16, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			16, 3, 3, 16, 16, 16, 16, 16, 3, 16, 3, 15, 15, 15, 15, 15,
-			15, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 16,
-			16, 16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 15, 15, 3, 3, 3,
-			3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 15, 3, 15, 3, 15, 8, 8, 8,
-			8, 15, 15, 16, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			3, 3, 3, 3, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 3, 15, 15, 16, 16, 16, 16, 16, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 3, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 3, 3, 3, 3,
-			3, 15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 16, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 7, 7, 3, 3, 3, 3, 16, 16, 16, 16, 16,
-			16, 15, 15, 15, 15, 16, 16, 16, 16, 15, 15, 15, 16, 15, 15, 15,
-			16, 16, 15, 15, 15, 15, 15, 15, 15, 16, 16, 16, 15, 15, 15, 15,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 16, 15, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 15, 15, 15, 15, 3, 3, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 12, 3, 3, 3, 3, 3,
-			12, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 16,
-			16, 16, 16, 3, 3, 16, 16, 16, 16, 16, 16, 16, 3, 16, 3, 16,
-			16, 16, 16, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 3, 16, 16, 16, 16, 3, 3, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16,
-			16, 16, 3, 3, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 16, 16,
-			3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 16, 16, 3,
-			3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 15, 15, 15, 3, 3,
-			7, 3, 3, 3, 3, 7, 7, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 3, 3, 13, 13, 13, 13, 13, 13, 3, 3, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 7, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			4, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 8, 8, 3, 3, 3,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 3, 3, 3, 3,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 15, 15, 15, 15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 15, 15, 15, 7, 7, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 15, 15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16,
-			16, 3, 15, 15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 3, 3, 3, 16, 3, 3, 3, 3,
-			16, 15, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 9, 7, 3, 3, 3, 3, 9, 7, 3, 15, 15, 15,
-			15, 15, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3,
-			3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 3, 3,
-			3, 3, 16, 16, 16, 16, 16, 15, 15, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 16, 3, 3, 3,
-			3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 3, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 3,
-			3, 3, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 3,
-			3, 3, 7, 7, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 16, 16,
-			16, 16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 3, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3,
-			3, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 15, 15,
-			3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 3, 15, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 16, 7, 7, 7, 7, 3, 3, 3, 3, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			15, 15, 15, 15, 15, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 7, 7, 3, 3, 7, 7, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 7, 7, 3, 15, 15, 15, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 16, 16, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 3, 3,
-			7, 7, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3,
-			3, 3, 16, 16, 16, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 7, 7, 13, 13, 13, 13, 13, 13, 13, 13, 13, 3, 3,
-			3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			3, 3, 16, 16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 15, 15, 15, 3, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 16, 16,
-			16, 16, 15, 16, 16, 16, 16, 16, 16, 15, 16, 16, 15, 15, 15, 16,
-			3, 3, 3, 3, 3, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 13, 13, 13, 13, 13, 13, 13, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12,
-			13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 13,
-			13, 13, 13, 13, 13, 13, 12, 12, 12, 12, 12, 12, 12, 12, 13, 13,
-			13, 13, 13, 13, 3, 3, 12, 12, 12, 12, 12, 12, 3, 3, 13, 13,
-			13, 13, 13, 13, 13, 13, 12, 12, 12, 12, 12, 12, 12, 12, 13, 13,
-			13, 13, 13, 13, 13, 13, 12, 12, 12, 12, 12, 12, 12, 12, 13, 13,
-			13, 13, 13, 13, 3, 3, 12, 12, 12, 12, 12, 12, 3, 3, 13, 13,
-			13, 13, 13, 13, 13, 13, 3, 12, 3, 12, 3, 12, 3, 12, 13, 13,
-			13, 13, 13, 13, 13, 13, 12, 12, 12, 12, 12, 12, 12, 12, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 3, 3, 13, 13,
-			13, 13, 13, 13, 13, 13, 12, 12, 12, 12, 12, 12, 12, 12, 13, 13,
-			13, 13, 13, 13, 13, 13, 12, 12, 12, 12, 12, 12, 12, 12, 13, 13,
-			13, 13, 13, 13, 13, 13, 12, 12, 12, 12, 12, 12, 12, 12, 13, 13,
-			13, 13, 13, 3, 13, 13, 12, 12, 12, 12, 12, 3, 13, 3, 3, 13,
-			13, 13, 3, 13, 13, 12, 12, 12, 12, 12, 3, 3, 3, 13, 13, 13,
-			13, 3, 3, 13, 13, 12, 12, 12, 12, 3, 3, 3, 3, 13, 13, 13,
-			13, 13, 13, 13, 13, 12, 12, 12, 12, 12, 3, 3, 3, 3, 3, 13,
-			13, 13, 3, 13, 13, 12, 12, 12, 12, 12, 3, 3, 3, 4, 4, 4,
-			4, 4, 4, 4, 4, 4, 4, 4, 15, 15, 15, 15, 15, 3, 3, 3,
-			9, 9, 3, 3, 3, 8, 8, 8, 8, 8, 8, 8, 8, 3, 3, 3,
-			3, 10, 3, 3, 3, 14, 14, 15, 15, 15, 15, 15, 4, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 8, 8, 3, 7, 7, 3, 3, 3, 3, 3,
-			8, 8, 7, 7, 7, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 15, 15, 15, 15, 15,
-			3, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 13, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 8, 8, 13, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 8, 8, 3, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 12, 3, 3, 3, 3,
-			12, 3, 3, 13, 12, 12, 12, 13, 13, 12, 12, 12, 13, 3, 12, 3,
-			3, 3, 12, 12, 12, 12, 12, 3, 3, 3, 3, 3, 3, 12, 3, 12,
-			3, 12, 3, 12, 12, 12, 12, 3, 13, 12, 12, 12, 12, 13, 16, 16,
-			16, 16, 13, 3, 3, 13, 13, 12, 12, 3, 3, 3, 3, 3, 12, 13,
-			13, 13, 13, 3, 3, 3, 3, 13, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 16, 16, 16, 12, 13, 16, 16,
-			16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 8, 8, 8, 8, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 8, 8, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			8, 8, 8, 8, 8, 8, 3, 3, 3, 3, 3, 3, 3, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 8, 8, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 8, 8, 8,
-			8, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 8, 8, 3, 3, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 12, 13, 12, 12, 12, 13, 13, 12, 13, 12, 13,
-			12, 13, 12, 12, 12, 12, 13, 12, 13, 13, 12, 13, 13, 13, 13, 13,
-			13, 13, 13, 12, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 13, 3, 3, 3, 3, 3, 3, 12,
-			13, 12, 13, 15, 15, 15, 12, 13, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 3, 13, 3, 3, 3, 3,
-			3, 13, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 3,
-			3, 3, 3, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 15, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 16, 16,
-			16, 16, 16, 3, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 16, 16,
-			16, 16, 16, 3, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
-			8, 8, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			8, 8, 3, 3, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 3, 3,
-			3, 3, 7, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			7, 3, 3, 3, 8, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 7, 7, 8, 8, 8, 8, 8, 8, 8, 8, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 4, 9, 7, 3, 3, 16, 16, 16, 8, 8, 8, 8, 8, 8,
-			8, 8, 8, 8, 3, 3, 8, 8, 8, 8, 8, 8, 8, 8, 3, 8,
-			8, 8, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 15,
-			15, 15, 3, 16, 16, 16, 16, 16, 3, 3, 16, 16, 16, 16, 16, 3,
-			3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 15, 15, 3, 3, 16,
-			16, 16, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 16,
-			16, 16, 16, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 3, 7, 7, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 16, 15, 15, 15, 15, 3, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 3, 16, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 13, 13, 15, 15, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 3, 7, 3, 3, 3, 7,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			3, 3, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			13, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 12, 13, 12, 13, 12,
-			12, 13, 12, 13, 12, 13, 12, 13, 16, 3, 3, 12, 13, 12, 13, 16,
-			12, 13, 12, 13, 13, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 12, 12, 12, 12, 13,
-			12, 12, 12, 12, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13, 12, 13,
-			12, 12, 12, 12, 13, 12, 13, 3, 3, 3, 3, 3, 12, 13, 3, 13,
-			3, 13, 12, 13, 12, 13, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16,
-			16, 12, 13, 16, 13, 13, 13, 16, 16, 16, 16, 16, 15, 16, 16, 16,
-			15, 16, 16, 16, 16, 15, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15,
-			15, 15, 3, 3, 3, 3, 15, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3,
-			7, 7, 3, 3, 3, 3, 3, 3, 3, 3, 15, 15, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 3, 3, 3, 3, 3,
-			3, 3, 7, 7, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			3, 3, 3, 3, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 16, 16, 16, 16, 16, 16, 3, 3, 3, 16,
-			3, 16, 16, 15, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 15, 15, 15,
-			15, 15, 3, 7, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 15, 15, 15,
-			15, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 3, 3,
-			3, 3, 3, 3, 7, 7, 3, 3, 3, 3, 3, 16, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16,
-			16, 15, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 16, 16, 16, 16, 16, 3, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 15,
-			16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 3, 3, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 3, 3, 3, 7, 7, 7, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 3, 3, 3, 16, 15, 15, 15, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 16, 15, 15, 15, 16,
-			16, 15, 15, 16, 16, 16, 16, 16, 15, 15, 16, 15, 16, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 16, 16, 16, 3, 3, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 15, 15, 15, 15, 15, 7, 7, 16, 16, 16, 15,
-			15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16,
-			3, 3, 16, 16, 16, 16, 16, 16, 3, 3, 16, 16, 16, 16, 16, 16,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16,
-			3, 16, 16, 16, 16, 16, 16, 16, 3, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 3, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 16, 3, 3, 3, 3, 3, 3, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 15, 15,
-			15, 15, 15, 15, 7, 15, 15, 3, 3, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 3, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3,
-			3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 13, 13, 13, 13, 13, 13, 13, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 13, 13, 13, 13, 13, 3, 3, 3, 3, 3, 16,
-			15, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 16, 16, 16, 3,
-			16, 3, 16, 16, 3, 16, 16, 3, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 8, 8,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			3, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 3, 3, 3, 3, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 9, 9, 3, 9, 3, 3, 3, 8,
-			8, 3, 3, 3, 3, 3, 3, 3, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 3, 9, 9, 3, 3, 8, 8, 8,
-			8, 8, 8, 8, 8, 8, 8, 8, 3, 3, 8, 8, 3, 3, 3, 3,
-			3, 3, 3, 9, 9, 10, 3, 3, 9, 7, 7, 9, 8, 8, 8, 8,
-			8, 8, 3, 3, 3, 3, 9, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 16, 16, 16, 16, 16, 3, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 3, 3, 15, 3, 7, 3, 3, 3, 3, 3,
-			3, 8, 8, 3, 3, 9, 9, 10, 3, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 9, 3, 3, 3, 3, 7, 3, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 8, 3, 8, 3, 3, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 8, 3, 8, 3, 8, 8, 7, 8, 8, 9, 3, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 15, 15, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 16,
-			16, 16, 16, 16, 16, 3, 3, 16, 16, 16, 16, 16, 16, 3, 3, 16,
-			16, 16, 16, 16, 16, 3, 3, 16, 16, 16, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 15, 15, 15, 3, 3, 3, 3, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16, 3, 16, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 15, 3, 3, 16, 16, 16,
-			16, 16, 16, 15, 15, 15, 15, 15, 3, 3, 3, 3, 3, 12, 12, 12,
-			3, 12, 12, 3, 13, 13, 13, 13, 13, 13, 13, 13, 13, 3, 13, 13,
-			13, 13, 13, 13, 13, 3, 13, 13, 3, 3, 3, 13, 16, 16, 13, 13,
-			13, 3, 13, 13, 13, 13, 13, 13, 13, 13, 13, 3, 3, 3, 3, 3,
-			16, 16, 16, 16, 16, 16, 3, 3, 16, 3, 16, 16, 16, 16, 16, 16,
-			3, 16, 16, 3, 3, 3, 16, 3, 3, 16, 16, 16, 3, 16, 16, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16,
-			16, 3, 3, 3, 3, 3, 3, 16, 16, 15, 15, 15, 3, 15, 15, 3,
-			3, 3, 3, 3, 15, 15, 15, 15, 16, 16, 16, 16, 16, 16, 3, 3,
-			15, 15, 15, 3, 3, 3, 3, 15, 3, 3, 3, 3, 3, 3, 7, 7,
-			3, 3, 3, 3, 3, 3, 3, 3, 12, 12, 12, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 3, 15, 15, 3, 3, 3, 3, 7, 7, 7, 7, 7, 3, 3,
-			3, 3, 3, 3, 16, 16, 15, 15, 15, 15, 7, 7, 7, 7, 3, 3,
-			3, 3, 3, 3, 15, 15, 15, 15, 15, 15, 15, 7, 7, 3, 3, 3,
-			3, 3, 3, 3, 15, 16, 16, 15, 15, 16, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 3, 3,
-			15, 7, 7, 15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 15, 3,
-			3, 7, 7, 7, 16, 15, 15, 16, 3, 3, 3, 3, 3, 3, 3, 3,
-			16, 16, 16, 15, 3, 3, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			15, 16, 16, 16, 16, 7, 7, 3, 3, 15, 15, 15, 15, 7, 15, 15,
-			11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 16, 3, 16, 3, 7, 7,
-			15, 15, 15, 15, 15, 15, 15, 15, 7, 7, 3, 7, 7, 3, 15, 3,
-			16, 16, 16, 16, 16, 16, 16, 3, 16, 3, 16, 16, 16, 16, 3, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 7, 3, 3, 3, 3, 3, 3, 15,
-			15, 15, 15, 3, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 16, 3,
-			16, 16, 3, 16, 16, 16, 16, 16, 3, 15, 15, 16, 15, 15, 16, 3,
-			3, 3, 3, 3, 3, 15, 3, 3, 3, 3, 3, 16, 16, 16, 15, 15,
-			3, 3, 15, 15, 15, 15, 15, 15, 15, 3, 3, 3, 15, 15, 15, 15,
-			15, 15, 15, 16, 16, 16, 16, 7, 7, 3, 3, 3, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 15, 16, 15, 15, 15, 15,
-			16, 16, 3, 16, 3, 3, 3, 3, 3, 3, 3, 3, 15, 15, 15, 15,
-			15, 15, 3, 3, 15, 15, 15, 15, 15, 15, 15, 15, 3, 7, 7, 3,
-			3, 3, 3, 3, 7, 7, 7, 7, 7, 7, 7, 7, 16, 16, 16, 16,
-			15, 15, 3, 3, 15, 7, 7, 3, 16, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 3, 3,
-			7, 7, 7, 3, 16, 16, 16, 16, 16, 16, 16, 3, 3, 16, 3, 3,
-			16, 16, 16, 16, 3, 16, 16, 3, 16, 16, 16, 16, 16, 16, 16, 16,
-			15, 15, 15, 15, 15, 15, 3, 15, 15, 3, 3, 15, 15, 15, 15, 16,
-			15, 15, 7, 3, 7, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 15,
-			15, 15, 15, 15, 15, 15, 3, 3, 15, 15, 15, 15, 15, 15, 16, 3,
-			16, 15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 15, 15,
-			15, 15, 15, 15, 15, 15, 15, 15, 16, 16, 16, 16, 16, 15, 15, 15,
-			15, 15, 15, 15, 16, 15, 15, 15, 15, 3, 3, 7, 7, 3, 3, 3,
-			15, 3, 3, 3, 3, 3, 3, 3, 3, 16, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 15, 15, 16, 16, 16, 16, 15, 15, 15, 15, 15, 15, 15,
-			15, 15, 15, 3, 7, 7, 16, 3, 3, 16, 7, 7, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 15, 15, 15, 15, 15, 15,
-			3, 3, 3, 15, 3, 15, 15, 3, 15, 15, 15, 15, 15, 15, 16, 15,
-			3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 3, 16,
-			16, 3, 16, 16, 16, 16, 16, 16, 15, 15, 3, 15, 15, 15, 15, 15,
-			16, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 15, 15, 15, 15, 7,
-			7, 3, 3, 3, 3, 3, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 3, 3, 3, 3, 7, 7, 15, 15, 15, 15, 15, 7, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 7, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 3, 3, 3, 3, 3, 3,
-			15, 15, 15, 15, 15, 15, 15, 15, 3, 3, 3, 3, 3, 3, 3, 15,
-			16, 16, 3, 16, 15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			16, 16, 16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 3, 3, 3, 15, 15, 7, 3, 3, 3, 3,
-			3, 15, 15, 15, 15, 15, 3, 3, 3, 15, 15, 15, 3, 3, 15, 15,
-			15, 15, 15, 15, 15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 15,
-			15, 15, 15, 3, 3, 15, 15, 15, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 13, 13, 13, 13, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 12,
-			3, 12, 12, 3, 3, 12, 3, 3, 12, 12, 3, 3, 12, 12, 12, 12,
-			3, 12, 12, 12, 12, 12, 12, 13, 13, 13, 13, 3, 13, 3, 13, 13,
-			13, 13, 12, 12, 3, 12, 12, 12, 12, 3, 3, 12, 12, 12, 12, 12,
-			3, 12, 12, 12, 12, 12, 12, 12, 3, 13, 13, 13, 13, 13, 13, 13,
-			13, 12, 12, 3, 12, 12, 12, 12, 3, 12, 12, 12, 12, 12, 3, 12,
-			3, 3, 3, 12, 12, 12, 12, 12, 12, 3, 13, 13, 13, 13, 13, 13,
-			13, 13, 13, 13, 13, 13, 13, 13, 12, 12, 12, 12, 13, 13, 13, 13,
-			13, 13, 3, 3, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3,
-			13, 13, 13, 13, 13, 3, 13, 13, 13, 13, 13, 13, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 3, 13, 13, 13, 13, 13, 13, 3, 13, 13,
-			13, 13, 13, 13, 12, 13, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11,
-			11, 11, 11, 11, 11, 11, 11, 11, 3, 3, 3, 3, 15, 3, 3, 3,
-			7, 3, 3, 3, 3, 3, 3, 3, 15, 15, 15, 15, 15, 15, 15, 15,
-			15, 3, 3, 15, 15, 15, 15, 15, 3, 15, 15, 3, 15, 15, 15, 15,
-			15, 3, 3, 3, 3, 3, 15, 15, 15, 15, 15, 15, 15, 16, 16, 16,
-			16, 16, 16, 16, 3, 3, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11,
-			3, 3, 3, 3, 16, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 15, 3, 16, 16, 16, 16, 16, 16, 16, 3, 16, 16,
-			16, 16, 3, 16, 16, 3, 13, 13, 13, 13, 15, 15, 15, 15, 15, 15,
-			15, 16, 3, 3, 3, 3, 16, 16, 3, 16, 3, 3, 16, 3, 16, 16,
-			16, 16, 16, 16, 16, 3, 16, 16, 16, 16, 3, 16, 3, 16, 3, 3,
-			3, 3, 16, 3, 3, 3, 3, 16, 3, 16, 3, 16, 3, 16, 16, 16,
-			3, 16, 16, 3, 16, 3, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16,
-			16, 3, 16, 3, 3, 16, 16, 16, 16, 3, 16, 16, 16, 16, 3, 16,
-			16, 16, 16, 3, 16, 3, 16, 16, 16, 3, 16, 16, 16, 16, 16, 3,
-			16, 16, 16, 16, 16, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3,
-			3, 3, 3, 3, 3, 8, 8, 8, 3, 3, 3, 3, 3, 3, 3, 0,
-		},
-
-		nullValue: 3,
-	},
-}
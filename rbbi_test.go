@@ -1,6 +1,8 @@
 package rbbi
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -120,3 +122,347 @@ func TestPreviousBrokenEnd(t *testing.T) {
 	str := string([]byte{0x68, 0xcc, 0xb7, 0xcc, 0x8e, 0xcc, 0x87, 0xcc, 0x8b, 0xcd, 0x83, 0xcc, 0x84, 0xcc, 0x9d, 0xcd, 0x88, 0xcd, 0x89, 0x65, 0xcc, 0xb4, 0xcc, 0x8a, 0xcc, 0x82, 0xcc, 0x8f, 0xcc, 0x91, 0xcc, 0x8f, 0xcc, 0xbb, 0x6c, 0xcc, 0xb8, 0xcd, 0xa0, 0xcd, 0x82, 0xcc, 0xbf, 0xcd, 0x9a, 0xcc, 0xac, 0xcc, 0xa2, 0xcd, 0x87, 0xcc, 0x97, 0x6c, 0xcc, 0xb4, 0xcc, 0x8d, 0xcc, 0x93, 0xcd, 0x8c, 0xcd, 0x8b, 0xcc, 0xbc, 0xcd, 0x87, 0xcc, 0xa2, 0xcc, 0xa8, 0x6f, 0xcc, 0xb7, 0xcd, 0x8b, 0xcc})
 	testPrevious(t, str, []int{0, 19, 34, 53, 72, 77})
 }
+
+func TestFollowing(t *testing.T) {
+	str := "🐨🏴‍☠️❤️‍🔥🥕"
+	expected := map[int]int{0: 4, 4: 17, 8: 17, 17: 30, 30: 34}
+
+	rbbi := NewCharacterRBBI()
+	rbbi.SetCursor(NewStringCursor(str))
+
+	for offset, breakpoint := range expected {
+		pos, ok := rbbi.Following(offset)
+
+		if !ok || pos != breakpoint {
+			t.Errorf("Invalid following break %v for offset %v", pos, offset)
+		}
+
+		if rbbi.Current() != pos {
+			t.Error("Following did not update the cursor")
+		}
+	}
+
+	if _, ok := rbbi.Following(34); ok {
+		t.Error("Following was ok at end of string")
+	}
+}
+
+func TestPreceding(t *testing.T) {
+	str := "🐨🏴‍☠️❤️‍🔥🥕"
+	expected := map[int]int{4: 0, 8: 4, 17: 4, 20: 17, 34: 30}
+
+	rbbi := NewCharacterRBBI()
+	rbbi.SetCursor(NewStringCursor(str))
+
+	for offset, breakpoint := range expected {
+		pos, ok := rbbi.Preceding(offset)
+
+		if !ok || pos != breakpoint {
+			t.Errorf("Invalid preceding break %v for offset %v", pos, offset)
+		}
+
+		if rbbi.Current() != pos {
+			t.Error("Preceding did not update the cursor")
+		}
+	}
+
+	if _, ok := rbbi.Preceding(0); ok {
+		t.Error("Preceding was ok at start of string")
+	}
+}
+
+func TestIsBoundary(t *testing.T) {
+	str := "🐨🏴‍☠️❤️‍🔥🥕"
+	boundaries := map[int]bool{0: true, 4: true, 17: true, 30: true, 34: true}
+
+	rbbi := NewCharacterRBBI()
+	rbbi.SetCursor(NewStringCursor(str))
+
+	for offset := 0; offset <= len(str); offset++ {
+		if rbbi.IsBoundary(offset) != boundaries[offset] {
+			t.Errorf("Invalid boundary state for offset %v", offset)
+		}
+	}
+}
+
+func TestRuleStatus(t *testing.T) {
+	str := "hello, world 42 日本"
+	expected := []int{
+		RuleStatusWordLetter, RuleStatusWordNone, RuleStatusWordNone,
+		RuleStatusWordLetter, RuleStatusWordNone, RuleStatusWordNumber,
+		RuleStatusWordNone, RuleStatusWordIdeo,
+	}
+
+	rbbi := NewWordRBBI()
+	rbbi.SetCursor(NewStringCursor(str))
+
+	for i := 0; i < len(expected); i++ {
+		if _, ok := rbbi.Next(); !ok {
+			t.Fatal("Next reached end of string")
+		}
+
+		if status := rbbi.RuleStatus(); status != expected[i] {
+			t.Errorf("Invalid rule status %v for break %v", status, i)
+		}
+	}
+
+	// Preceding should report the status of the returned break
+	if pos, _ := rbbi.Preceding(15); pos != 13 || rbbi.RuleStatus() != RuleStatusWordNone {
+		t.Errorf("Invalid rule status %v for preceding break %v", rbbi.RuleStatus(), pos)
+	}
+
+	if pos, _ := rbbi.Following(13); pos != 15 || rbbi.RuleStatus() != RuleStatusWordNumber {
+		t.Errorf("Invalid rule status %v for following break %v", rbbi.RuleStatus(), pos)
+	}
+}
+
+func TestRuleStatuses(t *testing.T) {
+	str := "hello, 42 日本"
+	expected := []int{
+		RuleStatusWordLetter, RuleStatusWordNone, RuleStatusWordNone,
+		RuleStatusWordNumber, RuleStatusWordNone, RuleStatusWordIdeo,
+	}
+
+	rbbi := NewWordRBBI()
+	rbbi.SetCursor(NewStringCursor(str))
+
+	for i := 0; i < len(expected); i++ {
+		if _, ok := rbbi.Next(); !ok {
+			t.Fatal("Next reached end of string")
+		}
+
+		statuses := rbbi.RuleStatuses()
+		if len(statuses) != 1 || statuses[0] != expected[i] {
+			t.Errorf("Invalid rule statuses %v for break %v", statuses, i)
+		}
+
+		// The returned slice is a copy of the rule status table
+		statuses[0] = -1
+		if rbbi.RuleStatus() != expected[i] {
+			t.Error("Modifying the rule statuses changed the rule status table")
+		}
+	}
+}
+
+func TestLatin1FastPath(t *testing.T) {
+	// The Latin-1 categories are used instead of the trie by every Cursor,
+	// so they must match the trie
+	for _, rules := range []*Rules{CharacterRules(), LineRules(), SentenceRules(), WordRules()} {
+		data := decodedData(rules)
+
+		for c := rune(0); c < 256; c++ {
+			category, err := data.trie.get(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if uint32(rules.latin1Categories[c]) != category {
+				t.Fatalf("Invalid Latin-1 category %v of %U, expected %v", rules.latin1Categories[c], c, category)
+			}
+		}
+	}
+}
+
+func TestStringCursorDecoding(t *testing.T) {
+	// Every Latin-1 character in between text that exercises the lookahead
+	// rules, to compare decoding directly from the string of a StringCursor
+	// with decoding through the Cursor interface
+	var builder strings.Builder
+	for c := rune(0); c < 256; c++ {
+		builder.WriteString("Mr. Smith can't pay $3.50, é.\r\n")
+		builder.WriteRune(c)
+	}
+
+	str := builder.String()
+
+	for _, rules := range []*Rules{CharacterRules(), LineRules(), SentenceRules(), WordRules()} {
+		expected := allBoundaries(rules.NewRBBI(), str)
+
+		iter := rules.NewRBBI()
+		iter.SetCursor(NewRope(str).NewCursor())
+
+		boundaries := []int{0}
+		statuses := []int{0}
+		for pos, ok := iter.Next(); ok; pos, ok = iter.Next() {
+			boundaries = append(boundaries, pos)
+			statuses = append(statuses, iter.RuleStatus())
+		}
+
+		if !reflect.DeepEqual(boundaries, expected) {
+			t.Fatalf("Boundaries of a rope differ from those of a string")
+		}
+
+		iter.SetCursor(NewStringCursor(str))
+		for i := 1; i < len(statuses); i++ {
+			if iter.Next(); iter.RuleStatus() != statuses[i] {
+				t.Fatalf("Invalid rule status %v at %v, expected %v", iter.RuleStatus(), boundaries[i], statuses[i])
+			}
+		}
+	}
+}
+
+// Return the tables of the character rules with a forward table that
+// remembers a lookahead position after reading a letter, and accepts that
+// position after reading a line feed. A line feed that doesn't follow a letter
+// in the same call of Next() must not use the position of an earlier call.
+func staleLookaheadData() *rbbiData {
+	data := copyData(decodedData(&characterRules))
+	rowLength := int(data.forwardTable.rowLength)
+
+	letter, _ := data.trie.get('a')
+	lineFeed, _ := data.trie.get('\n')
+
+	table := make([]uint8, 4*rowLength)
+
+	// The start state moves to state 2 on a letter and to state 3 on a
+	// line feed
+	table[rowLength+rbbiRowNextStates+int(letter)] = 2
+	table[rowLength+rbbiRowNextStates+int(lineFeed)] = 3
+
+	// State 2 accepts and remembers lookahead result 2
+	table[2*rowLength+rbbiRowAccepting] = 1
+	table[2*rowLength+rbbiRowLookahead] = 2
+
+	// State 3 accepts lookahead result 2
+	table[3*rowLength+rbbiRowAccepting] = 2
+
+	data.forwardTable.stateCount = 4
+	data.forwardTable.lookaheadResultsSize = 3
+	data.forwardTable.bofRequired = false
+	data.forwardTable.table8 = table
+
+	return data
+}
+
+func TestStaleLookahead(t *testing.T) {
+	str := "a\na"
+	expected := []int{0, 1, 2, 3}
+
+	// A stale lookahead result makes Next() return the same break forever,
+	// so the number of calls is limited
+	iter := newBrokenRBBI(staleLookaheadData(), str)
+	boundaries := []int{0}
+	for i := 0; i < len(str); i++ {
+		if position, ok := iter.Next(); ok {
+			boundaries = append(boundaries, position)
+		}
+	}
+
+	if !reflect.DeepEqual(boundaries, expected) {
+		t.Errorf("Invalid boundaries %v using stale lookahead results, expected %v", boundaries, expected)
+	}
+
+	if boundaries := iter.AppendBoundaries(nil, str); !reflect.DeepEqual(boundaries, expected) {
+		t.Errorf("Invalid appended boundaries %v using stale lookahead results, expected %v", boundaries, expected)
+	}
+
+	if iter.Err() != nil {
+		t.Errorf("Unexpected error %v", iter.Err())
+	}
+}
+
+// Check Preceding() and IsBoundary() at every rune boundary of a text against
+// the breaks found by Next(). These use the safe reverse table to find a
+// position from which to iterate forward, so this fails when that position
+// isn't actually safe.
+func testPrecedingAndIsBoundary(t *testing.T, rules *Rules, str string) {
+	t.Helper()
+
+	expected := allBoundaries(rules.NewRBBI(), str)
+	boundaries := map[int]bool{}
+	for _, breakpoint := range expected {
+		boundaries[breakpoint] = true
+	}
+
+	iter := rules.NewRBBI()
+	iter.SetCursor(NewStringCursor(str))
+
+	preceding := 0
+	for offset := range str + "\x00" {
+		if offset > 0 {
+			if breakpoint, ok := iter.Preceding(offset); !ok || breakpoint != preceding {
+				t.Errorf("Invalid preceding break %v for offset %v of %q, expected %v", breakpoint, offset, str, preceding)
+			}
+		}
+
+		if iter.IsBoundary(offset) != boundaries[offset] {
+			t.Errorf("Invalid boundary state for offset %v of %q, expected %v", offset, str, boundaries[offset])
+		}
+
+		if boundaries[offset] {
+			preceding = offset
+		}
+	}
+}
+
+func TestPrecedingRegionalIndicators(t *testing.T) {
+	// Regional indicators pair up into flags, so whether there is a break
+	// between two of them depends on the number of regional indicators
+	// before them
+	str := "🇳🇱🇧🇪🇩🇪"
+
+	for name, rules := range map[string]*Rules{"character": CharacterRules(), "line": LineRules(), "word": WordRules()} {
+		iter := rules.NewRBBI()
+		iter.SetCursor(NewStringCursor(str))
+
+		for offset, breakpoint := range map[int]int{4: 0, 8: 0, 12: 8, 16: 8, 20: 16, 24: 16} {
+			if pos, ok := iter.Preceding(offset); !ok || pos != breakpoint {
+				t.Errorf("Invalid preceding %v break %v for offset %v", name, pos, offset)
+			}
+		}
+
+		for offset, boundary := range map[int]bool{4: false, 8: true, 12: false, 16: true, 20: false} {
+			if iter.IsBoundary(offset) != boundary {
+				t.Errorf("Invalid %v boundary state for offset %v", name, offset)
+			}
+		}
+
+		testPrecedingAndIsBoundary(t, rules, str)
+		testPrecedingAndIsBoundary(t, rules, "a 🇳🇱🇧🇪🇩 b🇳🇱🇧")
+	}
+}
+
+func TestPrecedingMidWord(t *testing.T) {
+	str := "The quick (“brown”) fox can’t jump 32.3 feet, right?"
+
+	iter := NewWordRBBI()
+	iter.SetCursor(NewStringCursor(str))
+
+	// Offset 6 is in the middle of "quick"
+	if pos, ok := iter.Preceding(6); !ok || pos != 4 {
+		t.Errorf("Invalid preceding break %v in the middle of a word", pos)
+	}
+
+	if iter.IsBoundary(6) {
+		t.Error("Offset 6 in the middle of a word is a boundary")
+	}
+
+	// Offset 43 is in the middle of "32.3", which is a single word
+	if pos, ok := iter.Preceding(43); !ok || pos != 41 {
+		t.Errorf("Invalid preceding break %v in the middle of a number", pos)
+	}
+
+	testPrecedingAndIsBoundary(t, WordRules(), str)
+	testPrecedingAndIsBoundary(t, SentenceRules(), str)
+	testPrecedingAndIsBoundary(t, CharacterRules(), str)
+}
+
+func TestPrecedingMidLine(t *testing.T) {
+	str := "The quick brown fox jumps over the lazy dog. A well-known (and long) line, with 3.14 numbers!\nNext line."
+
+	iter := NewLineRBBI()
+	iter.SetCursor(NewStringCursor(str))
+
+	// Offset 12 is in the middle of "brown ", 50 in "well-" and 96 in "Next"
+	for offset, breakpoint := range map[int]int{12: 10, 50: 47, 96: 94} {
+		if pos, ok := iter.Preceding(offset); !ok || pos != breakpoint {
+			t.Errorf("Invalid preceding line break %v for offset %v", pos, offset)
+		}
+
+		if iter.IsBoundary(offset) {
+			t.Errorf("Offset %v in the middle of a line segment is a boundary", offset)
+		}
+	}
+
+	testPrecedingAndIsBoundary(t, LineRules(), str)
+}
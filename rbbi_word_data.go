@@ -1,2103 +0,0 @@
-// This file is auto-generated by maketable.py
-
-package rbbi
-
-// Lookup tables for word breaks.
-var rbbiWordData rbbiData = rbbiData{
-	categoryCount: 31,
-
-	forwardTable: rbbiStateTable{
-		stateCount:           58,
-		rowLength:            34,
-		dictCategoriesStart:  24,
-		lookaheadResultsSize: 0,
-
-		lookaheadHardBreak: false,
-		bofRequired:        false,
-		valueWidth:         rbbiStateTableValueWidth8,
-
-		rows: []rbbiStateTableRow{
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 2, 3, 3, 4, 5, 2, 2, 2, 2, 6, 2, 7, 8,
-					2, 9, 10, 11, 7, 12, 13, 14, 7, 9, 15, 16, 17, 18, 19,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 2, 0, 20, 0, 0, 12, 0, 0, 2, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 2, 0, 20, 0, 0, 12, 0, 0, 2, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 21, 21, 21, 6, 0, 7, 22,
-					0, 6, 10, 23, 7, 0, 24, 0, 7, 6, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 25, 0, 25, 6, 25, 7, 26,
-					0, 7, 10, 27, 7, 0, 28, 0, 7, 7, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					0, 8, 10, 29, 7, 0, 30, 0, 7, 8, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 9, 0, 11, 0, 0, 13, 0, 0, 9, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 31, 32, 0, 25, 6, 25, 7, 26,
-					0, 10, 10, 33, 7, 0, 34, 0, 7, 10, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 9, 0, 11, 7, 0, 13, 0, 0, 9, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 12, 0, 35, 0, 0, 12, 0, 0, 12, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 13, 0, 36, 0, 0, 13, 0, 0, 13, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 14, 0, 37, 0, 0, 38, 2, 0, 14, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 2, 0, 20, 0, 0, 12, 0, 0, 2, 39, 16, 17, 18, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 12, 0, 35, 0, 0, 12, 0, 0, 12, 39, 16, 17, 18, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
-					0, 41, 0, 42, 0, 0, 43, 0, 0, 41, 39, 16, 17, 18, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 44, 0, 45, 0, 0, 46, 0, 0, 44, 39, 16, 17, 18, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 2, 0, 20, 0, 0, 12, 0, 0, 2, 0, 0, 0, 0, 19,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 2, 0, 20, 7, 0, 12, 0, 0, 2, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 0, 0,
-					0, 21, 0, 21, 0, 0, 21, 0, 0, 21, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					0, 8, 10, 29, 7, 0, 30, 0, 7, 8, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 21, 21, 21, 6, 0, 7, 22,
-					2, 6, 10, 23, 7, 0, 24, 0, 7, 6, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  8,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 21, 21, 21, 6, 0, 7, 22,
-					0, 24, 10, 47, 7, 0, 24, 0, 7, 24, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0,
-					0, 25, 10, 25, 7, 0, 25, 0, 7, 25, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					0, 8, 10, 29, 7, 0, 30, 0, 7, 8, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 25, 0, 25, 6, 25, 7, 26,
-					2, 7, 10, 27, 7, 0, 28, 0, 7, 7, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  11,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 25, 0, 25, 6, 25, 7, 26,
-					0, 28, 10, 48, 7, 0, 28, 0, 7, 28, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					2, 8, 10, 29, 7, 0, 30, 0, 7, 8, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					0, 30, 10, 49, 7, 0, 30, 0, 7, 30, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 31, 10, 31, 0, 0, 31, 0, 0, 31, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0,
-					0, 50, 10, 51, 7, 0, 52, 0, 7, 50, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 31, 32, 0, 25, 6, 25, 7, 26,
-					2, 10, 10, 33, 7, 0, 34, 0, 7, 10, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  11,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 31, 32, 0, 25, 6, 25, 7, 26,
-					0, 34, 10, 53, 7, 0, 34, 0, 7, 34, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 12, 0, 35, 7, 0, 12, 0, 0, 12, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 13, 0, 36, 7, 0, 13, 0, 0, 13, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 14, 0, 37, 7, 0, 38, 2, 0, 14, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 38, 0, 54, 0, 0, 38, 2, 0, 38, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 2, 0, 20, 0, 0, 12, 0, 0, 2, 39, 16, 17, 18, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					0, 8, 10, 29, 7, 0, 30, 0, 7, 8, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
-					0, 41, 0, 42, 0, 0, 43, 0, 0, 41, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
-					2, 41, 0, 42, 7, 0, 43, 0, 0, 41, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
-					0, 43, 0, 55, 0, 0, 43, 0, 0, 43, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 44, 0, 45, 0, 0, 46, 0, 0, 44, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 44, 0, 45, 7, 0, 46, 0, 0, 44, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 46, 0, 56, 0, 0, 46, 0, 0, 46, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  8,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 21, 21, 21, 6, 0, 7, 22,
-					2, 24, 10, 47, 7, 0, 24, 0, 7, 24, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  11,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 25, 0, 25, 6, 25, 7, 26,
-					2, 28, 10, 48, 7, 0, 28, 0, 7, 28, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					2, 30, 10, 49, 7, 0, 30, 0, 7, 30, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0,
-					0, 50, 10, 51, 7, 0, 52, 0, 7, 50, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0,
-					2, 50, 10, 51, 7, 0, 52, 0, 7, 50, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0,
-					0, 52, 10, 57, 7, 0, 52, 0, 7, 52, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  11,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 31, 32, 0, 25, 6, 25, 7, 26,
-					2, 34, 10, 53, 7, 0, 34, 0, 7, 34, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 38, 0, 54, 7, 0, 38, 2, 0, 38, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
-					2, 43, 0, 55, 7, 0, 43, 0, 0, 43, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 46, 0, 56, 7, 0, 46, 0, 0, 46, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0,
-					2, 52, 10, 57, 7, 0, 52, 0, 7, 52, 0, 0, 0, 0, 0,
-				},
-			},
-		},
-	},
-
-	reverseTable: rbbiStateTable{
-		stateCount:           58,
-		rowLength:            34,
-		dictCategoriesStart:  24,
-		lookaheadResultsSize: 0,
-
-		lookaheadHardBreak: false,
-		bofRequired:        false,
-		valueWidth:         rbbiStateTableValueWidth8,
-
-		rows: []rbbiStateTableRow{
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 2, 3, 3, 4, 5, 2, 2, 2, 2, 6, 2, 7, 8,
-					2, 9, 10, 11, 7, 12, 13, 14, 7, 9, 15, 16, 17, 18, 19,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 2, 0, 20, 0, 0, 12, 0, 0, 2, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 2, 0, 20, 0, 0, 12, 0, 0, 2, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 21, 21, 21, 6, 0, 7, 22,
-					0, 6, 10, 23, 7, 0, 24, 0, 7, 6, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 25, 0, 25, 6, 25, 7, 26,
-					0, 7, 10, 27, 7, 0, 28, 0, 7, 7, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					0, 8, 10, 29, 7, 0, 30, 0, 7, 8, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 9, 0, 11, 0, 0, 13, 0, 0, 9, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 31, 32, 0, 25, 6, 25, 7, 26,
-					0, 10, 10, 33, 7, 0, 34, 0, 7, 10, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 9, 0, 11, 7, 0, 13, 0, 0, 9, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 12, 0, 35, 0, 0, 12, 0, 0, 12, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 13, 0, 36, 0, 0, 13, 0, 0, 13, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 14, 0, 37, 0, 0, 38, 2, 0, 14, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 2, 0, 20, 0, 0, 12, 0, 0, 2, 39, 16, 17, 18, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 12, 0, 35, 0, 0, 12, 0, 0, 12, 39, 16, 17, 18, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
-					0, 41, 0, 42, 0, 0, 43, 0, 0, 41, 39, 16, 17, 18, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 44, 0, 45, 0, 0, 46, 0, 0, 44, 39, 16, 17, 18, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 2, 0, 20, 0, 0, 12, 0, 0, 2, 0, 0, 0, 0, 19,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 2, 0, 20, 7, 0, 12, 0, 0, 2, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 0, 0,
-					0, 21, 0, 21, 0, 0, 21, 0, 0, 21, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					0, 8, 10, 29, 7, 0, 30, 0, 7, 8, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  2,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 21, 21, 21, 6, 0, 7, 22,
-					2, 6, 10, 23, 7, 0, 24, 0, 7, 6, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  8,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 21, 21, 21, 6, 0, 7, 22,
-					0, 24, 10, 47, 7, 0, 24, 0, 7, 24, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0,
-					0, 25, 10, 25, 7, 0, 25, 0, 7, 25, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					0, 8, 10, 29, 7, 0, 30, 0, 7, 8, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 25, 0, 25, 6, 25, 7, 26,
-					2, 7, 10, 27, 7, 0, 28, 0, 7, 7, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  11,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 25, 0, 25, 6, 25, 7, 26,
-					0, 28, 10, 48, 7, 0, 28, 0, 7, 28, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					2, 8, 10, 29, 7, 0, 30, 0, 7, 8, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					0, 30, 10, 49, 7, 0, 30, 0, 7, 30, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 0,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 31, 10, 31, 0, 0, 31, 0, 0, 31, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0,
-					0, 50, 10, 51, 7, 0, 52, 0, 7, 50, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  4,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 31, 32, 0, 25, 6, 25, 7, 26,
-					2, 10, 10, 33, 7, 0, 34, 0, 7, 10, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  11,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 31, 32, 0, 25, 6, 25, 7, 26,
-					0, 34, 10, 53, 7, 0, 34, 0, 7, 34, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 12, 0, 35, 7, 0, 12, 0, 0, 12, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 13, 0, 36, 7, 0, 13, 0, 0, 13, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 14, 0, 37, 7, 0, 38, 2, 0, 14, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 38, 0, 54, 0, 0, 38, 2, 0, 38, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 2, 0, 20, 0, 0, 12, 0, 0, 2, 39, 16, 17, 18, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					0, 8, 10, 29, 7, 0, 30, 0, 7, 8, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
-					0, 41, 0, 42, 0, 0, 43, 0, 0, 41, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
-					2, 41, 0, 42, 7, 0, 43, 0, 0, 41, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
-					0, 43, 0, 55, 0, 0, 43, 0, 0, 43, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 44, 0, 45, 0, 0, 46, 0, 0, 44, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 44, 0, 45, 7, 0, 46, 0, 0, 44, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 46, 0, 56, 0, 0, 46, 0, 0, 46, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  8,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 21, 21, 21, 6, 0, 7, 22,
-					2, 24, 10, 47, 7, 0, 24, 0, 7, 24, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  11,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 25, 0, 25, 6, 25, 7, 26,
-					2, 28, 10, 48, 7, 0, 28, 0, 7, 28, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 7, 26,
-					2, 30, 10, 49, 7, 0, 30, 0, 7, 30, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0,
-					0, 50, 10, 51, 7, 0, 52, 0, 7, 50, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  0,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0,
-					2, 50, 10, 51, 7, 0, 52, 0, 7, 50, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0,
-					0, 52, 10, 57, 7, 0, 52, 0, 7, 52, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  11,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 31, 32, 0, 25, 6, 25, 7, 26,
-					2, 34, 10, 53, 7, 0, 34, 0, 7, 34, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 38, 0, 54, 7, 0, 38, 2, 0, 38, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 40,
-					2, 43, 0, 55, 7, 0, 43, 0, 0, 43, 0, 0, 17, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					2, 46, 0, 56, 7, 0, 46, 0, 0, 46, 0, 0, 0, 0, 0,
-				},
-			},
-			rbbiStateTableRow{
-				accepting: 1,
-				lookahead: 0,
-				tagIndex:  6,
-
-				nextStates: []uint8{
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0,
-					2, 52, 10, 57, 7, 0, 52, 0, 7, 52, 0, 0, 0, 0, 0,
-				},
-			},
-		},
-	},
-
-	trie: ucpTrie{
-		trieType:           ucpTrieTypeFast,
-		valueWidth:         ucpTrieValueWidth8,
-		dataLength:         11274,
-		index3NullOffset:   864,
-		dataNullOffset:     5788,
-		highStart:          918016,
-		shifted12HighStart: 225,
-		nullValueOffset:    5788,
-
-		index: []uint16{
-			0, 64, 123, 187, 243, 243, 243, 243, 243, 243, 243, 283, 347, 363, 427, 462,
-			243, 243, 524, 243, 540, 589, 644, 706, 770, 823, 243, 872, 936, 989, 1015, 1079,
-			1143, 1207, 1263, 337, 1291, 1353, 1416, 1478, 1541, 1603, 1666, 1728, 1792, 1854, 1916, 1978,
-			2042, 2104, 2168, 2230, 2294, 2356, 2420, 2484, 2547, 2611, 2674, 2738, 2802, 2866, 2925, 2986,
-			3050, 3114, 3176, 3234, 243, 243, 243, 243, 243, 3284, 3339, 3401, 3448, 3485, 3549, 3581,
-			242, 243, 243, 243, 243, 243, 243, 243, 243, 3645, 3709, 3741, 3805, 3869, 3933, 3985,
-			4038, 4070, 4134, 4182, 4246, 4306, 4370, 4424, 4488, 4531, 4595, 4644, 4708, 4767, 4831, 4889,
-			4953, 5017, 5070, 5134, 243, 243, 243, 347, 243, 243, 243, 243, 3613, 5198, 5262, 5325,
-			5389, 5452, 5516, 5564, 5626, 5690, 5745, 5788, 5788, 5788, 5788, 5788, 5826, 5788, 5858, 5907,
-			5788, 5788, 5966, 6028, 5788, 5788, 6070, 6124, 6188, 6208, 6266, 6208, 6324, 6384, 6427, 5788,
-			5788, 5788, 5788, 5788, 5800, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 6485, 6533, 5788, 5788,
-			243, 243, 243, 6597, 6661, 6709, 6773, 6805, 6869, 5788, 6933, 6960, 7024, 7024, 7024, 7066,
-			7130, 7193, 7234, 7266, 7330, 243, 7379, 7443, 5788, 5788, 7507, 7555, 7619, 7659, 5788, 5788,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 5788, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-			243, 243, 7787, 7835, 243, 243, 243, 243, 7884, 7948, 8011, 8043, 8099, 243, 243, 8152,
-			8214, 8278, 8342, 8400, 8464, 8521, 8585, 8648, 8712, 8776, 8838, 8902, 8965, 265, 243, 9013,
-			9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077,
-			9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077,
-			9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077,
-			9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077,
-			9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077,
-			9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077,
-			9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077,
-			9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077,
-			9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077,
-			9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077,
-			9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9077, 9105, 9162,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 7723, 7723, 7723, 7723, 7723, 9226, 7723, 9274, 9338, 9402, 9418, 7832,
-			243, 243, 243, 243, 245, 3565, 5244, 9460, 9524, 9577, 243, 9631, 9695, 9759, 9797, 9860,
-			2680, 2710, 2739, 2770, 2802, 2802, 2815, 2844, 2876, 2886, 2886, 2886, 2886, 2886, 2886, 2886,
-			2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886,
-			2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886, 2886,
-			2886, 2886, 2886, 2886, 2918, 198, 243, 203, 9924, 77, 77, 5788, 5788, 243, 243, 243,
-			243, 243, 243, 243, 112, 5788, 5788, 5788, 5788, 243, 243, 243, 3560, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 9940, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 243, 78, 243,
-			243, 243, 935, 1188, 5788, 243, 243, 1194, 243, 112, 243, 243, 9956, 243, 77, 243,
-			243, 5349, 8172, 5788, 5788, 243, 243, 243, 243, 243, 243, 243, 243, 243, 77, 1766,
-			243, 243, 5349, 243, 79, 243, 243, 3557, 243, 243, 243, 3561, 199, 199, 9972, 208,
-			9986, 5788, 5788, 5788, 5788, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-			243, 243, 243, 243, 243, 243, 243, 243, 3558, 243, 3559, 3557, 5788, 204, 243, 243,
-			10002, 5788, 5788, 5788, 5788, 10018, 243, 243, 10028, 243, 3559, 243, 3558, 243, 76, 5788,
-			5788, 5788, 5788, 243, 10043, 243, 3559, 243, 297, 5788, 5788, 5788, 5788, 243, 243, 243,
-			299, 5788, 5788, 5788, 5788, 10059, 1676, 243, 10075, 5788, 5788, 243, 78, 243, 78, 5788,
-			5788, 202, 243, 3882, 5788, 243, 243, 243, 3559, 243, 3559, 243, 3562, 243, 3563, 5788,
-			5788, 5788, 5788, 5788, 5788, 243, 243, 243, 243, 3556, 5788, 5788, 5788, 243, 243, 243,
-			3562, 243, 243, 243, 3562, 243, 243, 3819, 1766, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 243, 243, 10091, 3563, 5788, 5788, 5788, 5788, 243, 78, 2795,
-			243, 341, 1188, 5788, 243, 10107, 5788, 5788, 243, 3560, 5788, 243, 3558, 531, 243, 243,
-			339, 4652, 5788, 1073, 10123, 531, 243, 243, 10138, 10149, 243, 3556, 1766, 531, 243, 340,
-			10165, 10181, 243, 243, 10197, 531, 243, 243, 344, 10213, 10229, 5788, 5788, 243, 208, 335,
-			2978, 5788, 5788, 5788, 5788, 10245, 196, 3556, 243, 243, 332, 2975, 1766, 10261, 1432, 201,
-			10276, 1854, 10292, 10306, 1184, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 243, 243, 243,
-			342, 10322, 10338, 3563, 5788, 243, 243, 243, 347, 10354, 1766, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 243, 243, 332, 10370, 1188, 10386, 5788, 5788, 243, 243, 243,
-			347, 10399, 1766, 5788, 5788, 243, 243, 336, 1056, 1766, 5788, 5788, 5788, 2548, 10415, 10428,
-			10444, 10459, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 243, 243, 335,
-			2975, 5788, 5788, 5788, 5788, 5788, 5788, 243, 243, 243, 243, 1766, 1192, 10475, 10487, 243,
-			10503, 10517, 1766, 5788, 5788, 5788, 5788, 3627, 243, 243, 10533, 10548, 5788, 10564, 243, 243,
-			10577, 10592, 10608, 243, 243, 337, 10624, 5788, 243, 243, 243, 243, 3556, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 201, 243, 332,
-			2942, 935, 1766, 5788, 63, 243, 659, 2941, 4652, 5788, 5788, 5788, 5788, 1708, 243, 243,
-			10640, 10655, 1766, 10671, 243, 4501, 10687, 1766, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 243, 3820, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 935, 5788, 5788, 5788, 5788, 243, 243, 243, 243, 243, 243, 243, 243,
-			243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-			243, 243, 243, 243, 243, 243, 243, 243, 297, 5788, 5788, 5788, 5788, 5788, 5788, 243,
-			243, 243, 243, 243, 243, 76, 5788, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-			243, 243, 243, 3561, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 243, 243, 243,
-			243, 243, 243, 935, 243, 243, 76, 4650, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 243, 243, 243, 243, 3558, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 243, 243, 243, 3556, 243, 76, 1766, 243, 243, 243, 243, 76,
-			1766, 243, 77, 1184, 243, 243, 243, 4652, 3561, 1766, 62, 1242, 243, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 243, 243, 243, 243, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			243, 243, 243, 243, 1963, 346, 347, 347, 10703, 531, 5788, 5788, 5788, 5788, 10719, 7086,
-			10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735,
-			10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735,
-			10743, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10745, 5788,
-			5788, 10742, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 10761, 10777, 7194, 7194, 7194, 7194, 7194, 7194, 7194, 7194, 7194, 7194, 7194, 7194, 7194,
-			7194, 7194, 7194, 7194, 7504, 5788, 5788, 10790, 10802, 10735, 10735, 10735, 10735, 10735, 10735, 10735,
-			10735, 10735, 10735, 10735, 10735, 10735, 10735, 10735, 10739, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 243, 243, 243, 243, 243, 243, 112,
-			78, 3556, 10818, 1185, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 347, 347, 986, 347, 4652, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 10833, 347, 10846, 5788, 10858, 5788, 5788, 5788, 5788, 5788, 3512, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 243, 243, 243, 243, 243, 205,
-			243, 243, 243, 197, 10872, 10886, 206, 243, 243, 243, 3287, 1577, 243, 3283, 10899, 209,
-			243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 3637, 243, 209, 199, 243, 199,
-			243, 205, 243, 205, 76, 243, 76, 243, 201, 243, 201, 243, 10912, 10926, 10926, 10926,
-			347, 347, 347, 4283, 347, 347, 2973, 1996, 1997, 759, 660, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			243, 76, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			2942, 10942, 10956, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			243, 243, 78, 10972, 10988, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			243, 11004, 5788, 243, 243, 335, 1766, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 11020, 76, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-			243, 243, 243, 3560, 4652, 5788, 5788, 243, 243, 243, 243, 11036, 1766, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 206, 243, 11051, 11064, 11078, 11094, 11108, 11116, 200,
-			79, 11131, 79, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208,
-			6208, 6208, 6208, 6208, 6208, 6208, 6208, 5935, 5788, 149, 243, 297, 243, 11142, 11158, 11174,
-			11189, 5935, 6208, 6208, 6208, 11205, 11211, 11227, 5856, 149, 11243, 5939, 6208, 6208, 6208, 6208,
-			6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208,
-			6208, 6208, 6208, 6208, 6208, 6208, 11255, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208,
-			6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6258, 5942, 6208, 6208, 6208, 6208,
-			6208, 6208, 6208, 6208, 6208, 6208, 6208, 5788, 5788, 5788, 6208, 6208, 6208, 6208, 6208, 6208,
-			6208, 6208, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 6278, 5788, 5788, 5788, 5788, 5788, 5943,
-			6208, 6208, 5936, 5788, 5788, 5788, 5940, 5755, 5788, 5788, 5940, 5788, 5772, 6208, 6208, 6208,
-			6208, 6208, 5936, 6208, 6208, 6196, 6188, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208,
-			6208, 6208, 6208, 6208, 6208, 6208, 6208, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 1766, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208,
-			6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208, 6208,
-			6208, 6208, 6208, 6208, 6208, 6208, 6208, 6258, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 5788, 5788, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 9291, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 9258, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 9298, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7137, 5788, 7723, 9258, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723,
-			7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 7723, 9289, 5788, 5788, 5788,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 2000, 5788, 347, 347, 347, 347, 347, 347,
-			5788, 5788, 5788, 5788, 5788, 5788, 5788, 5788, 347, 347, 347, 347, 347, 347, 347, 347,
-			347, 347, 347, 347, 347, 347, 347, 5788, 1077, 1109, 1141, 1173, 1205, 1237, 1269, 1293,
-			1325, 1357, 1389, 1421, 1453, 1485, 1517, 1544, 1576, 1583, 1615, 864, 864, 864, 864, 1636,
-			1576, 1576, 1668, 864, 864, 864, 864, 864, 1576, 1700, 864, 864, 864, 864, 864, 864,
-			864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 1576, 1732, 864, 1760, 1792, 1792,
-			1792, 1792, 1792, 1792, 1792, 1792, 1793, 1792, 1792, 1825, 864, 864, 864, 864, 864, 864,
-			864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 1842, 1874, 1897, 864, 864, 864,
-			864, 1929, 864, 864, 864, 864, 864, 864, 864, 1945, 1966, 1994, 2026, 2048, 864, 2080,
-			864, 2096, 2128, 2151, 864, 2167, 2199, 864, 864, 2231, 2263, 2295, 2327, 2354, 2386, 2407,
-			2439, 2440, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208,
-			208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208,
-			208, 208, 2472, 208, 208, 208, 208, 208, 208, 208, 2488, 2519, 208, 208, 208, 208,
-			208, 208, 208, 2541, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208,
-			208, 2552, 864, 864, 864, 864, 864, 864, 208, 2584, 864, 864, 208, 208, 208, 208,
-			208, 208, 208, 208, 208, 2616, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864,
-			864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864, 864,
-			864, 864, 864, 864, 864, 864, 2648,
-		},
-
-		data8: []uint8{
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 5, 5, 6, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			7, 3, 8, 3, 3, 3, 3, 9, 3, 3, 3, 3, 10, 3, 11, 3,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 13, 10, 3, 3, 3, 3,
-			3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 15,
-			3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 3,
-			5, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 16, 14, 3, 3, 17, 16, 3, 3, 3, 3, 3, 3,
-			14, 3, 13, 3, 3, 14, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 14, 14, 14, 14, 14,
-			3, 14, 14, 3, 3, 14, 14, 14, 14, 10, 14, 3, 3, 3, 3, 3,
-			3, 14, 13, 14, 14, 14, 3, 14, 3, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 17,
-			17, 17, 17, 17, 17, 17, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 3, 3, 14, 14, 14, 14, 3, 14, 13, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 10, 14, 3,
-			3, 3, 3, 3, 3, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 3, 17, 17, 3, 17, 17, 3, 17, 3, 3, 3, 3, 3, 3,
-			3, 3, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
-			18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 3, 3, 3,
-			3, 18, 18, 18, 18, 14, 13, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 17, 17, 17, 17, 17, 17, 3, 3, 3, 3, 3, 3, 10, 10,
-			3, 3, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 3, 17, 3,
-			3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 3, 12, 10, 3, 14, 14, 17, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 17, 17,
-			17, 17, 17, 17, 17, 17, 3, 17, 17, 17, 17, 17, 17, 14, 14, 17,
-			17, 3, 17, 17, 17, 17, 14, 14, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 14, 14, 14, 3, 3, 14, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 17, 14, 17, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 3, 3, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 14, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 17, 17, 17, 17, 17, 17, 17, 17, 17, 14, 14, 3, 3, 10,
-			3, 14, 3, 3, 17, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 17,
-			17, 14, 17, 17, 17, 17, 17, 17, 17, 17, 17, 14, 17, 17, 17, 14,
-			17, 17, 17, 17, 17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			17, 17, 17, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 3, 17,
-			17, 3, 3, 3, 3, 3, 3, 17, 17, 17, 17, 17, 17, 17, 17, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 17, 17, 17, 14, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 14, 17, 17, 17, 17, 17, 17,
-			17, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 3, 3, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 17, 3, 14, 14, 14,
-			14, 14, 14, 14, 14, 3, 3, 14, 14, 3, 3, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 3, 14, 14, 14, 14, 14, 14, 14, 3, 14, 3, 3, 3, 14, 14,
-			14, 14, 3, 3, 17, 14, 17, 17, 17, 17, 17, 3, 3, 17, 17, 3,
-			3, 17, 17, 17, 14, 3, 3, 3, 3, 3, 3, 3, 3, 17, 3, 3,
-			3, 3, 14, 14, 3, 14, 14, 14, 17, 17, 3, 3, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 14, 14, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 14, 3, 17, 3, 17, 17, 17, 3, 14, 14, 14, 14, 14, 14,
-			3, 3, 3, 3, 14, 14, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14,
-			14, 14, 14, 14, 14, 14, 3, 14, 14, 3, 14, 14, 3, 14, 14, 3,
-			3, 17, 3, 17, 17, 17, 3, 3, 3, 3, 17, 17, 3, 3, 17, 17,
-			17, 3, 3, 3, 17, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14,
-			3, 14, 3, 3, 3, 3, 3, 3, 3, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 17, 17, 14, 14, 14, 17, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 17, 17, 17, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			3, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14, 14,
-			14, 14, 14, 3, 14, 14, 3, 14, 14, 14, 14, 14, 3, 3, 17, 14,
-			17, 17, 17, 17, 17, 17, 3, 17, 17, 17, 3, 17, 17, 17, 3, 3,
-			14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			14, 14, 17, 17, 3, 3, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 17, 17, 17, 17, 17, 17,
-			3, 17, 17, 17, 3, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 14,
-			14, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14,
-			14, 3, 14, 14, 3, 14, 14, 14, 14, 14, 3, 3, 17, 14, 17, 17,
-			17, 17, 17, 3, 3, 17, 17, 3, 3, 17, 17, 17, 3, 3, 3, 3,
-			3, 3, 3, 17, 17, 17, 3, 3, 3, 3, 14, 14, 3, 14, 14, 14,
-			17, 17, 3, 3, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 14,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 17, 14,
-			3, 14, 14, 14, 14, 14, 14, 3, 3, 3, 14, 14, 14, 3, 14, 14,
-			14, 14, 3, 3, 3, 14, 14, 3, 14, 3, 14, 14, 3, 3, 3, 14,
-			14, 3, 3, 3, 14, 14, 14, 3, 3, 3, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 17, 17, 17, 3, 3, 3,
-			17, 17, 17, 3, 17, 17, 17, 17, 3, 3, 14, 3, 3, 3, 3, 3,
-			3, 17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 17, 17, 17, 17, 17, 14,
-			14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14, 3, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 3, 3, 17, 14, 17, 17, 17, 17, 17, 3, 17, 17,
-			17, 3, 17, 17, 17, 17, 3, 3, 3, 3, 3, 3, 3, 17, 17, 3,
-			14, 14, 14, 3, 3, 14, 3, 3, 14, 14, 17, 17, 3, 3, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 14, 17, 17, 17, 3, 14, 14, 14,
-			14, 14, 14, 14, 14, 3, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14,
-			14, 14, 3, 3, 17, 14, 17, 17, 17, 17, 17, 3, 17, 17, 17, 3,
-			17, 17, 17, 17, 3, 3, 3, 3, 3, 3, 3, 17, 17, 3, 3, 3,
-			3, 3, 3, 14, 14, 3, 14, 14, 17, 17, 3, 3, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 3, 14, 14, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 17, 17, 17, 17, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 3, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 17, 17, 14, 17, 17, 17, 17, 17, 3, 17, 17, 17, 3, 17, 17,
-			17, 17, 14, 3, 3, 3, 3, 3, 14, 14, 14, 17, 3, 3, 3, 3,
-			3, 3, 3, 14, 14, 14, 17, 17, 3, 3, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14,
-			14, 14, 14, 14, 3, 17, 17, 17, 3, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			3, 14, 3, 3, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 17, 3,
-			3, 3, 3, 17, 17, 17, 17, 17, 17, 3, 17, 3, 17, 17, 17, 17,
-			17, 17, 17, 17, 3, 3, 3, 3, 3, 3, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 3, 3, 17, 17, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 25, 24, 24, 25, 25, 25, 25, 25, 25, 25, 3, 3,
-			3, 3, 3, 24, 24, 24, 24, 24, 24, 24, 25, 25, 25, 25, 25, 25,
-			25, 25, 3, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 24, 24, 3, 24, 3, 24, 24, 24, 24, 24, 3, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 3, 24, 3, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 25, 24, 24, 25, 25, 25, 25, 25, 25, 25, 25, 25, 24,
-			3, 3, 24, 24, 24, 24, 24, 3, 24, 3, 25, 25, 25, 25, 25, 25,
-			3, 3, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 3, 24, 24,
-			24, 24, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 17, 17, 3, 3, 3, 3,
-			3, 3, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 17, 3, 17, 3, 17, 3, 3, 3, 3,
-			17, 17, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3,
-			3, 3, 3, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 3, 17, 17, 14, 14, 14, 14, 14, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 3, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 3, 3, 3, 3, 3, 3,
-			17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-			25, 25, 25, 25, 25, 25, 25, 25, 25, 24, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 3, 3, 3, 3, 3, 3, 24, 24, 24, 24, 24, 24,
-			25, 25, 25, 25, 24, 24, 24, 24, 25, 25, 25, 24, 25, 25, 25, 24,
-			24, 25, 25, 25, 25, 25, 25, 25, 24, 24, 24, 25, 25, 25, 25, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 25, 25, 25, 25, 25, 25,
-			25, 25, 25, 25, 25, 25, 24, 25, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 25, 25, 25, 25, 24, 24, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 3, 3, 3, 3, 3, 14,
-			3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14,
-			14, 14, 3, 3, 14, 14, 14, 14, 14, 14, 14, 3, 14, 3, 14, 14,
-			14, 14, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 3, 14, 14, 14, 14, 3, 3, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14,
-			14, 3, 3, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14, 14, 3,
-			3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14, 14, 3, 3,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 17, 17, 17, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 3, 3, 14, 14, 14, 14, 14, 14, 3, 3, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 7, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 3, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17,
-			17, 17, 17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17,
-			17, 17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17,
-			17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14, 3, 17,
-			17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-			25, 25, 25, 25, 25, 3, 3, 3, 24, 3, 3, 3, 3, 24, 25, 3,
-			3, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 17, 17, 17, 17, 17, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-			3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3,
-			3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 17, 17, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17,
-			14, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 3, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 3, 3, 3, 3, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 3, 3, 3, 3, 3, 3, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			3, 3, 24, 24, 24, 24, 24, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 3, 3,
-			3, 3, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 3, 3, 3, 3, 3, 3, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 24, 3, 3, 3, 24, 24, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17,
-			17, 17, 17, 17, 3, 3, 3, 3, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 25, 25, 25, 25, 25, 25, 25, 25,
-			25, 25, 3, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-			25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-			3, 3, 17, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 3, 3,
-			3, 3, 3, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 3, 3,
-			3, 3, 3, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 3, 3, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 17, 17, 17, 17, 17, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 17,
-			17, 17, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 14, 14, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 3, 3, 3, 3, 3, 3, 3, 3, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 3, 3, 3, 14, 14, 14, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 12, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 3, 3, 3, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 14, 14, 14, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 17, 17,
-			17, 3, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 14, 14, 14, 14, 17, 14, 14, 14, 14,
-			14, 14, 17, 14, 14, 17, 17, 17, 14, 3, 3, 3, 3, 3, 14, 14,
-			14, 14, 14, 14, 3, 3, 14, 14, 14, 14, 14, 14, 3, 3, 14, 14,
-			14, 14, 14, 14, 14, 14, 3, 14, 3, 14, 3, 14, 3, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 3, 14, 3, 3, 14,
-			14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 14, 14, 14,
-			14, 3, 3, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 3, 14,
-			14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 7, 7, 7,
-			7, 7, 7, 7, 3, 7, 7, 7, 3, 17, 19, 17, 17, 3, 3, 3,
-			3, 3, 3, 3, 3, 11, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 11, 3, 3, 13, 5, 5, 17, 17, 17, 17, 17, 15, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 3, 3, 15, 3, 3, 3,
-			10, 3, 3, 3, 3, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			15, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 17, 17, 17, 17,
-			17, 3, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 3, 14, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 3, 3, 3,
-			3, 14, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14,
-			3, 3, 3, 14, 14, 14, 14, 14, 3, 3, 3, 3, 16, 3, 14, 3,
-			14, 3, 14, 3, 14, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 20, 3, 3, 14, 14, 14, 14, 3, 3, 3, 3, 3, 14,
-			14, 14, 14, 14, 3, 3, 3, 3, 14, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 3, 3, 3, 3, 16, 16, 16, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 20, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 3, 16, 16, 16, 16,
-			16, 16, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 3, 16, 3, 16, 3, 3, 3, 3, 3,
-			3, 16, 3, 3, 3, 16, 3, 3, 3, 3, 3, 3, 16, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 16, 16, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 16, 3, 3, 16, 3, 3, 3, 3, 16, 3, 16, 3,
-			3, 3, 3, 16, 16, 16, 3, 16, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 16, 16, 16, 16, 16, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			16, 16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 16, 16, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			16, 16, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 16, 3, 3, 3, 3, 16, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 3, 3,
-			14, 14, 14, 14, 17, 17, 17, 14, 14, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 14, 3, 3, 3,
-			3, 3, 14, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3,
-			3, 3, 3, 3, 14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 17, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14,
-			14, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14,
-			14, 14, 14, 14, 3, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 3,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 3, 3, 3, 26,
-			21, 27, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 27, 27, 27, 27, 27,
-			27, 27, 27, 27, 17, 17, 17, 17, 17, 17, 16, 28, 28, 28, 28, 28,
-			3, 3, 27, 27, 27, 26, 14, 16, 3, 3, 29, 29, 29, 29, 29, 29,
-			29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29,
-			29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29,
-			29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29,
-			29, 29, 29, 29, 29, 29, 29, 29, 29, 3, 3, 17, 17, 28, 28, 29,
-			29, 29, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 3, 28, 28,
-			28, 28, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 28, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 16, 3, 16, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 3, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 28, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 27, 27, 27, 27, 27,
-			27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
-			27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
-			27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
-			27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 14, 14, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 17, 17, 3,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 3, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 3, 3, 3, 3, 3, 14, 14, 3, 14, 3, 14, 14, 14,
-			14, 14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 17, 14, 14, 14, 17, 14, 14, 14,
-			14, 17, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 17, 17, 17, 3, 3,
-			3, 3, 17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 17, 17, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 3, 3, 3, 3, 3,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 14, 14, 14, 14, 14, 14, 3, 3, 3, 14, 3, 14, 14, 17,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 17, 17, 17, 17, 17, 17, 17, 17, 3, 3,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 3, 3, 3, 17, 17, 17, 17, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 14, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 3, 3, 3, 3, 3, 3, 24, 24, 24, 24, 24, 25, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 24, 24, 24, 24, 24, 3, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 17, 14, 14, 14, 14,
-			14, 14, 14, 14, 17, 17, 3, 3, 12, 12, 12, 12, 12, 12, 12, 12,
-			12, 12, 3, 3, 3, 3, 3, 3, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 25, 25, 25, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-			24, 24, 24, 24, 24, 24, 25, 24, 25, 25, 25, 24, 24, 25, 25, 24,
-			24, 24, 24, 24, 25, 25, 24, 25, 24, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 24, 24, 24, 24, 24, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 17, 17, 17, 17, 17, 3, 3, 14, 14, 14, 17, 17, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 3, 3, 14, 14,
-			14, 14, 14, 14, 3, 3, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14,
-			14, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 17, 17, 17, 17, 17, 17, 17, 17,
-			3, 17, 17, 3, 3, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3,
-			3, 3, 3, 3, 3, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30,
-			30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30,
-			30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30,
-			30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30,
-			30, 30, 30, 30, 30, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 27, 27, 27, 27, 27, 27,
-			27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
-			27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
-			27, 27, 27, 27, 27, 27, 27, 27, 3, 3, 27, 27, 27, 27, 27, 27,
-			27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
-			27, 27, 27, 27, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 14,
-			14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14,
-			14, 14, 3, 3, 3, 3, 3, 18, 17, 18, 18, 18, 18, 18, 18, 18,
-			18, 18, 18, 3, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
-			18, 3, 18, 18, 18, 18, 18, 3, 18, 3, 18, 18, 3, 18, 18, 3,
-			18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			3, 3, 3, 3, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 10, 3, 3, 13, 10, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 3, 3, 3, 15, 15, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 15, 15, 15, 10, 3, 11, 3, 10, 13, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14, 3, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 17, 3,
-			3, 3, 3, 3, 3, 3, 11, 3, 3, 3, 3, 10, 3, 11, 3, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 13, 10, 3, 3, 3, 3, 3,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 15, 3,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
-			28, 28, 28, 17, 17, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 3, 3, 14, 14, 14, 14, 14, 14, 3, 3, 14, 14,
-			14, 14, 14, 14, 3, 3, 14, 14, 14, 14, 14, 14, 3, 3, 14, 14,
-			14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 17, 17, 17,
-			3, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3,
-			14, 14, 3, 14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 17, 3, 3, 14, 14, 14, 14, 14, 14, 17, 17, 17, 17, 17, 3,
-			3, 3, 3, 3, 14, 14, 14, 3, 14, 14, 3, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 3,
-			3, 3, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3,
-			3, 3, 14, 14, 14, 14, 14, 14, 3, 3, 14, 3, 14, 14, 14, 14,
-			14, 14, 3, 14, 14, 3, 3, 3, 14, 3, 3, 14, 14, 14, 3, 14,
-			14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 17, 17, 17, 3,
-			17, 17, 3, 3, 3, 3, 3, 17, 17, 17, 17, 14, 14, 14, 14, 14,
-			14, 3, 3, 17, 17, 17, 3, 3, 3, 3, 17, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 3, 17, 17, 3, 3, 3, 14, 14, 17, 17, 17,
-			17, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 17, 14, 14, 17, 17,
-			14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 17, 17, 17, 17, 17, 17,
-			17, 17, 17, 17, 17, 3, 3, 17, 3, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 17, 3, 3, 17, 17, 17, 17, 17, 3, 12, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 3, 3, 3, 3, 14, 17, 17, 14, 3, 3, 3,
-			3, 3, 3, 3, 3, 14, 14, 14, 17, 3, 3, 14, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 17, 14, 14, 14, 14, 3, 3, 3, 3, 17, 17,
-			17, 17, 3, 17, 17, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 14,
-			3, 14, 3, 3, 3, 14, 14, 14, 14, 14, 14, 14, 3, 14, 3, 14,
-			14, 14, 14, 3, 14, 17, 17, 17, 17, 3, 14, 14, 14, 14, 14, 14,
-			14, 14, 3, 3, 14, 3, 14, 14, 3, 14, 14, 14, 14, 14, 3, 17,
-			17, 14, 17, 17, 14, 3, 3, 3, 3, 3, 3, 17, 3, 3, 3, 3,
-			3, 14, 14, 14, 17, 17, 3, 3, 17, 17, 17, 17, 17, 17, 17, 3,
-			3, 3, 17, 17, 17, 17, 17, 17, 17, 14, 14, 14, 14, 3, 3, 3,
-			3, 3, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 3, 3, 3, 3,
-			17, 14, 17, 17, 17, 17, 14, 14, 3, 14, 3, 3, 3, 3, 3, 3,
-			3, 3, 17, 17, 17, 17, 17, 17, 3, 3, 17, 17, 17, 17, 17, 17,
-			17, 17, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 17, 17,
-			3, 3, 3, 14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 24,
-			24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 3, 3, 25, 25, 25, 25,
-			25, 25, 25, 25, 25, 25, 25, 25, 3, 3, 3, 3, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 24, 24, 3, 3, 3, 24, 24, 24, 24, 24,
-			24, 24, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14, 14, 14, 14, 14,
-			14, 14, 3, 3, 14, 3, 3, 14, 14, 14, 14, 3, 14, 14, 3, 14,
-			14, 14, 14, 14, 14, 14, 14, 17, 17, 17, 17, 17, 17, 3, 17, 17,
-			3, 3, 17, 17, 17, 17, 14, 17, 17, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 14, 17, 17, 17, 17, 17, 17, 17, 3, 3, 17,
-			17, 17, 17, 17, 17, 14, 3, 14, 17, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 14, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 14,
-			14, 14, 14, 14, 17, 17, 17, 17, 17, 17, 17, 14, 17, 17, 17, 17,
-			3, 3, 3, 3, 3, 3, 3, 17, 3, 3, 3, 3, 3, 3, 3, 3,
-			14, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 14, 14, 14, 14,
-			17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 3, 3, 3, 14, 3, 3,
-			14, 17, 17, 17, 17, 17, 17, 3, 3, 3, 17, 3, 17, 17, 3, 17,
-			17, 17, 17, 17, 17, 14, 17, 3, 3, 3, 3, 3, 3, 3, 3, 14,
-			14, 14, 14, 14, 14, 3, 14, 14, 3, 14, 14, 14, 14, 14, 14, 17,
-			17, 3, 17, 17, 17, 17, 17, 14, 3, 3, 3, 3, 3, 3, 3, 17,
-			17, 17, 17, 17, 17, 17, 17, 3, 3, 3, 3, 3, 3, 3, 17, 14,
-			14, 26, 26, 22, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 21,
-			21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 3,
-			3, 3, 3, 3, 3, 3, 3, 3, 3, 28, 28, 28, 28, 3, 28, 28,
-			28, 28, 28, 28, 28, 3, 28, 28, 3, 28, 29, 29, 29, 29, 29, 29,
-			29, 29, 29, 29, 29, 29, 29, 29, 29, 3, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 3, 3, 28, 28, 28, 28, 3, 3, 3, 3, 3, 3,
-			3, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 3, 17,
-			17, 3, 3, 3, 3, 3, 17, 17, 17, 17, 17, 3, 3, 3, 17, 17,
-			17, 3, 3, 17, 17, 17, 17, 17, 17, 17, 3, 3, 3, 3, 3, 3,
-			3, 3, 3, 3, 17, 17, 17, 17, 3, 3, 14, 3, 3, 14, 14, 3,
-			3, 14, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			3, 14, 3, 14, 14, 14, 14, 14, 3, 14, 3, 3, 3, 14, 14, 14,
-			14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 3, 3, 12, 12,
-			12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 17, 17,
-			17, 17, 17, 17, 17, 17, 17, 3, 3, 17, 17, 17, 17, 17, 3, 17,
-			17, 3, 17, 17, 17, 17, 17, 3, 3, 3, 3, 3, 17, 17, 17, 17,
-			17, 17, 17, 14, 14, 14, 14, 14, 14, 14, 3, 3, 12, 12, 12, 12,
-			12, 12, 12, 12, 12, 12, 3, 3, 3, 3, 14, 3, 14, 14, 14, 14,
-			14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 17, 3, 14, 14, 14, 14,
-			14, 14, 14, 3, 14, 14, 14, 14, 3, 14, 14, 3, 14, 14, 14, 14,
-			17, 17, 17, 17, 17, 17, 17, 14, 3, 3, 3, 3, 14, 14, 3, 14,
-			3, 3, 14, 3, 14, 14, 14, 14, 14, 14, 14, 3, 14, 14, 14, 14,
-			3, 14, 3, 14, 3, 3, 3, 3, 14, 3, 3, 3, 3, 14, 3, 14,
-			3, 14, 3, 14, 14, 14, 3, 14, 14, 3, 14, 3, 3, 14, 3, 14,
-			3, 14, 3, 14, 3, 14, 14, 3, 14, 3, 3, 14, 14, 14, 14, 3,
-			14, 14, 14, 14, 3, 14, 14, 14, 14, 3, 14, 3, 14, 14, 14, 3,
-			14, 14, 14, 14, 14, 3, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			3, 3, 16, 16, 16, 16, 20, 20, 14, 14, 14, 14, 14, 14, 14, 14,
-			14, 14, 14, 14, 20, 20, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-			3, 3, 3, 3, 16, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			3, 3, 3, 3, 3, 16, 16, 16, 16, 16, 16, 23, 23, 23, 23, 23,
-			23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 29, 16, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 3, 3, 16, 16, 16,
-			16, 16, 16, 16, 16, 16, 3, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-			16, 16, 17, 17, 17, 17, 17, 3, 3, 0,
-		},
-
-		nullValue: 3,
-	},
-}
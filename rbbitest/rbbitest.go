@@ -0,0 +1,372 @@
+// Package rbbitest implements support for testing Cursor implementations and
+// break iterators. It checks that the iteration functions of a break iterator
+// agree with each other when it uses a Cursor, and with the breaks that the
+// same rules find in a plain string.
+package rbbitest
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	rbbi "github.com/thedjinn/rbbi-go"
+)
+
+// A CursorFactory returns a new Cursor over the provided text, positioned at
+// the start of the text.
+type CursorFactory func(text string) rbbi.Cursor
+
+// Texts is a set of strings that exercise the break rules, including emoji
+// sequences, combining marks, several scripts and invalid UTF-8. It can be
+// passed to Check().
+var Texts = []string{
+	"",
+	"a",
+	"Hello, world! How are you?\r\nFine, thanks. Mr. Smith paid $3.50 (e.g. for “tea”).",
+	"Größe 🐨🏴‍☠️❤️‍🔥 👩‍❤️‍👩 🏃🏻‍♂️‍➡️ 🇧🇷🇦🇷🇫🇷 x 🇩🇪",
+	"日本語のテキスト。「한글」ＡＢＣ１ 中文文本！",
+	"क्षत्रिय नमस्ते। مرحبا بالعالم؟ ١٥ درهمًا.",
+	"Z̤͔ͧ̑̓ä͖̭̈̇lͮ̒ͫǧ̗͚̚o̙̔ͮ̇͐̇",
+	"if (a <= b && c[i] != \"x\") { return -1; } // 3.14e-10",
+	"\xff\x80abc\xf0\x9f\x90 \xe6\x97 \xed\xa0\x80z",
+}
+
+// A Discrepancy describes where a break iterator using a Cursor disagreed
+// with the expected result.
+type Discrepancy struct {
+	Text      string // The text being iterated
+	Offset    int    // The byte offset in Text of the Cursor position that was used
+	Operation string // The operation that failed, e.g. "Following(12)"
+	Got       string
+	Expected  string
+}
+
+func (d *Discrepancy) Error() string {
+	return fmt.Sprintf("rbbitest: %v at byte offset %v of %q: got %v, expected %v", d.Operation, d.Offset, context(d.Text, d.Offset), d.Got, d.Expected)
+}
+
+// Return the text around a byte offset, with the offset marked by a '|'.
+func context(text string, offset int) string {
+	const size = 12
+
+	start := offset - size
+	if start < 0 {
+		start = 0
+	}
+
+	for start > 0 && !utf8.RuneStart(text[start]) {
+		start--
+	}
+
+	end := offset + size
+	if end > len(text) {
+		end = len(text)
+	}
+
+	for end < len(text) && !utf8.RuneStart(text[end]) {
+		end++
+	}
+
+	return text[start:offset] + "|" + text[offset:end]
+}
+
+// Check runs the break iterator over each of the texts, using Cursors
+// returned by newCursor, and returns a *Discrepancy for the first
+// disagreement it finds, or nil when there is none.
+//
+// The Cursor must yield the runes of the text as decoded by a range loop
+// over the string, and every rune must have a distinct position. The
+// positions are otherwise treated as opaque. For every text Check verifies
+// that:
+//
+//   - Next() finds the same breaks and rule statuses as the rules do for a
+//     StringCursor over the text,
+//   - Previous() finds the same breaks in reverse order,
+//   - Following() and Preceding() return the nearest break after and before
+//     the position of every rune, and
+//   - IsBoundary() reports exactly the breaks.
+//
+// The Cursor of the break iterator is replaced.
+func Check(iter *rbbi.RBBI, newCursor CursorFactory, texts []string) error {
+	for _, text := range texts {
+		if err := checkText(iter, newCursor, text); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The state for checking a single text.
+type checker struct {
+	iter   *rbbi.RBBI
+	cursor rbbi.Cursor
+	text   string
+
+	// The byte offsets of the runes of the text followed by the end of the
+	// text, and the corresponding Cursor positions
+	offsets   []int
+	positions []int
+
+	// The rune index of each Cursor position
+	indexes map[int]int
+
+	// The rune indexes of the expected breaks and their rule statuses
+	breaks   []int
+	statuses []int
+}
+
+func checkText(iter *rbbi.RBBI, newCursor CursorFactory, text string) error {
+	c := &checker{
+		iter:    iter,
+		text:    text,
+		indexes: make(map[int]int),
+	}
+
+	var runes []rune
+	for offset, r := range text {
+		c.offsets = append(c.offsets, offset)
+		runes = append(runes, r)
+	}
+
+	c.offsets = append(c.offsets, len(text))
+
+	if err := c.findBreaks(); err != nil {
+		return err
+	}
+
+	c.cursor = newCursor(text)
+	if err := c.checkCursor(runes); err != nil {
+		return err
+	}
+
+	iter.SetCursor(c.cursor)
+
+	for _, check := range []func() error{c.checkNext, c.checkPrevious, c.checkRandomAccess} {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Return a discrepancy at the byte offset of a rune.
+func (c *checker) discrepancy(index int, operation string, got, expected interface{}) error {
+	return &Discrepancy{
+		Text:      c.text,
+		Offset:    c.offsets[index],
+		Operation: operation,
+		Got:       fmt.Sprint(got),
+		Expected:  fmt.Sprint(expected),
+	}
+}
+
+// Return the byte offset for a Cursor position, or -1 if the Cursor did not
+// report the position for any rune.
+func (c *checker) offset(position int) int {
+	index, ok := c.indexes[position]
+	if !ok {
+		return -1
+	}
+
+	return c.offsets[index]
+}
+
+// Find the expected breaks using a StringCursor.
+func (c *checker) findBreaks() error {
+	reference := c.iter.Clone()
+	reference.SetCursor(rbbi.NewStringCursor(c.text))
+
+	index := 0
+	c.breaks = []int{0}
+	c.statuses = []int{0}
+
+	for {
+		offset, ok := reference.Next()
+		if !ok {
+			break
+		}
+
+		for c.offsets[index] < offset {
+			index++
+		}
+
+		c.breaks = append(c.breaks, index)
+		c.statuses = append(c.statuses, reference.RuleStatus())
+	}
+
+	if err := reference.Err(); err != nil {
+		return fmt.Errorf("rbbitest: iterating %q using a StringCursor: %w", c.text, err)
+	}
+
+	return nil
+}
+
+// Check that the Cursor yields the runes of the text in both directions, and
+// record its positions.
+func (c *checker) checkCursor(runes []rune) error {
+	position := c.cursor.Position()
+
+	for index := 0; ; index++ {
+		if _, ok := c.indexes[position]; ok {
+			return c.discrepancy(index, "Cursor.Position()", position, "a position distinct from those of previous runes")
+		}
+
+		c.indexes[position] = index
+		c.positions = append(c.positions, position)
+
+		r, ok := c.cursor.Next()
+		if index == len(runes) {
+			if ok {
+				return c.discrepancy(index, "Cursor.Next()", fmt.Sprintf("%q", r), "the end of the text")
+			}
+
+			break
+		}
+
+		if !ok || r != runes[index] {
+			return c.discrepancy(index, "Cursor.Next()", fmt.Sprintf("%q (%v)", r, ok), fmt.Sprintf("%q", runes[index]))
+		}
+
+		position = c.cursor.Position()
+	}
+
+	for index := len(runes) - 1; index >= 0; index-- {
+		r, ok := c.cursor.Previous()
+		if !ok || r != runes[index] || c.cursor.Position() != c.positions[index] {
+			return c.discrepancy(index, "Cursor.Previous()", fmt.Sprintf("%q (%v) at position %v", r, ok, c.cursor.Position()), fmt.Sprintf("%q at position %v", runes[index], c.positions[index]))
+		}
+	}
+
+	if r, ok := c.cursor.Previous(); ok {
+		return c.discrepancy(0, "Cursor.Previous()", fmt.Sprintf("%q", r), "the start of the text")
+	}
+
+	return nil
+}
+
+// Move the Cursor to the position of a rune.
+func (c *checker) setPosition(index int) error {
+	if err := c.cursor.SetPosition(c.positions[index]); err != nil {
+		return c.discrepancy(index, fmt.Sprintf("Cursor.SetPosition(%v)", c.positions[index]), err, "no error")
+	}
+
+	return nil
+}
+
+// Return a discrepancy for an error of the break iterator.
+func (c *checker) checkErr(index int, operation string) error {
+	if err := c.iter.Err(); err != nil {
+		return c.discrepancy(index, operation, err, "no error")
+	}
+
+	return nil
+}
+
+// Check a break returned by an iteration function, and the rule status of
+// the break iterator. The expected break is an index into c.breaks, or -1
+// when no break is expected.
+func (c *checker) checkBreak(index int, operation string, position int, ok bool, expected int) error {
+	if err := c.checkErr(index, operation); err != nil {
+		return err
+	}
+
+	if expected < 0 {
+		if ok {
+			return c.discrepancy(index, operation, fmt.Sprintf("a break at byte offset %v", c.offset(position)), "no break")
+		}
+
+		return nil
+	}
+
+	offset := c.offsets[c.breaks[expected]]
+	if !ok || c.offset(position) != offset {
+		return c.discrepancy(index, operation, fmt.Sprintf("a break at byte offset %v (%v)", c.offset(position), ok), fmt.Sprintf("a break at byte offset %v", offset))
+	}
+
+	if status := c.iter.RuleStatus(); status != c.statuses[expected] {
+		return c.discrepancy(index, operation+".RuleStatus()", status, c.statuses[expected])
+	}
+
+	return nil
+}
+
+func (c *checker) checkNext() error {
+	if err := c.setPosition(0); err != nil {
+		return err
+	}
+
+	for i := 1; i <= len(c.breaks); i++ {
+		index := c.breaks[i-1]
+
+		expected := i
+		if i == len(c.breaks) {
+			expected = -1
+		}
+
+		position, ok := c.iter.Next()
+		if err := c.checkBreak(index, "Next()", position, ok, expected); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *checker) checkPrevious() error {
+	if err := c.setPosition(len(c.positions) - 1); err != nil {
+		return err
+	}
+
+	for i := len(c.breaks) - 2; i >= -1; i-- {
+		position, ok := c.iter.Previous()
+		if err := c.checkBreak(c.breaks[i+1], "Previous()", position, ok, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *checker) checkRandomAccess() error {
+	// The index of the first break at or after the current rune
+	next := 0
+
+	for index, position := range c.positions {
+		if c.breaks[next] < index {
+			next++
+		}
+
+		isBoundary := c.breaks[next] == index
+
+		following := next
+		if isBoundary {
+			following++
+		}
+
+		if following == len(c.breaks) {
+			following = -1
+		}
+
+		found, ok := c.iter.Following(position)
+		if err := c.checkBreak(index, fmt.Sprintf("Following(%v)", position), found, ok, following); err != nil {
+			return err
+		}
+
+		found, ok = c.iter.Preceding(position)
+		if err := c.checkBreak(index, fmt.Sprintf("Preceding(%v)", position), found, ok, next-1); err != nil {
+			return err
+		}
+
+		operation := fmt.Sprintf("IsBoundary(%v)", position)
+		if c.iter.IsBoundary(position) != isBoundary {
+			return c.discrepancy(index, operation, !isBoundary, isBoundary)
+		}
+
+		if err := c.checkErr(index, operation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
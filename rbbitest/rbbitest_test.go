@@ -0,0 +1,110 @@
+package rbbitest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode"
+
+	rbbi "github.com/thedjinn/rbbi-go"
+)
+
+var iterators = map[string]func() *rbbi.RBBI{
+	"character": rbbi.NewCharacterRBBI,
+	"line":      rbbi.NewLineRBBI,
+	"sentence":  rbbi.NewSentenceRBBI,
+	"word":      rbbi.NewWordRBBI,
+}
+
+// A Cursor over a rune slice, using rune indexes as positions.
+type runeCursor struct {
+	runes    []rune
+	position int
+}
+
+func (c *runeCursor) Position() int {
+	return c.position
+}
+
+func (c *runeCursor) SetPosition(position int) error {
+	if position < 0 || position > len(c.runes) {
+		return rbbi.ErrPositionOutOfRange
+	}
+
+	c.position = position
+	return nil
+}
+
+func (c *runeCursor) Next() (rune, bool) {
+	if c.position >= len(c.runes) {
+		return -1, false
+	}
+
+	c.position++
+	return c.runes[c.position-1], true
+}
+
+func (c *runeCursor) Previous() (rune, bool) {
+	if c.position <= 0 {
+		return -1, false
+	}
+
+	c.position--
+	return c.runes[c.position], true
+}
+
+// A broken Cursor that yields upper case runes in the forward direction.
+type upperCursor struct {
+	*runeCursor
+}
+
+func (c upperCursor) Next() (rune, bool) {
+	r, ok := c.runeCursor.Next()
+	return unicode.ToUpper(r), ok
+}
+
+func TestCheck(t *testing.T) {
+	factories := map[string]CursorFactory{
+		"string": func(text string) rbbi.Cursor {
+			return rbbi.NewStringCursor(text)
+		},
+		"rope": func(text string) rbbi.Cursor {
+			rope := rbbi.NewRope("")
+			for _, part := range strings.SplitAfter(text, " ") {
+				rope.Insert(rope.Len(), part)
+			}
+
+			return rope.NewCursor()
+		},
+		"rune": func(text string) rbbi.Cursor {
+			return &runeCursor{runes: []rune(text)}
+		},
+	}
+
+	for name, newRBBI := range iterators {
+		for cursorName, factory := range factories {
+			if err := Check(newRBBI(), factory, Texts); err != nil {
+				t.Errorf("%v rules with %v cursor: %v", name, cursorName, err)
+			}
+		}
+	}
+}
+
+func TestCheckDiscrepancy(t *testing.T) {
+	err := Check(rbbi.NewWordRBBI(), func(text string) rbbi.Cursor {
+		return upperCursor{&runeCursor{runes: []rune(text)}}
+	}, []string{"ABC def"})
+
+	var discrepancy *Discrepancy
+	if !errors.As(err, &discrepancy) {
+		t.Fatalf("Expected a discrepancy, got %v", err)
+	}
+
+	if discrepancy.Offset != 4 || discrepancy.Operation != "Cursor.Next()" {
+		t.Errorf("Invalid discrepancy %v", err)
+	}
+
+	if !strings.Contains(err.Error(), `"ABC |def"`) {
+		t.Errorf("Discrepancy %q does not show the context", err)
+	}
+}
@@ -0,0 +1,593 @@
+package rbbi
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// The maximum number of bytes stored in a single rope node
+const ropeMaxChunkLength = 1024
+
+// The Rope is a text buffer that supports efficient insertion and deletion at
+// arbitrary positions. It is implemented as a treap (a randomized balanced
+// binary tree) where every node stores a chunk of the text, so edits and
+// lookups take O(log n) time. Positions are byte offsets relative to the start
+// of the text.
+//
+// Cursors over the Rope can be created with NewCursor(), which allows the
+// break iterators to scan the text without flattening it into a string.
+type Rope struct {
+	root *ropeNode
+
+	// Incremented on every edit, used to invalidate Cursor state
+	version int
+
+	// State of the xorshift generator used for node priorities
+	seed uint32
+}
+
+type ropeNode struct {
+	left  *ropeNode
+	right *ropeNode
+
+	text     string
+	priority uint32
+
+	// Total number of bytes in the subtree rooted at this node
+	length int
+}
+
+// Instantiate a new Rope containing the provided text.
+func NewRope(text string) *Rope {
+	r := &Rope{
+		seed: 2463534242,
+	}
+
+	r.root = r.build(text)
+	return r
+}
+
+// Return the length of the text in bytes.
+func (r *Rope) Len() int {
+	return r.root.size()
+}
+
+// Return the text stored in the Rope as a string.
+func (r *Rope) String() string {
+	var builder strings.Builder
+	builder.Grow(r.Len())
+
+	r.root.walk(func(text string) {
+		builder.WriteString(text)
+	})
+
+	return builder.String()
+}
+
+// Return the part of the text in the byte range [from, to). An error is
+// returned when the range is outside the text's boundaries.
+func (r *Rope) Slice(from, to int) (string, error) {
+	if from < 0 || to > r.Len() || from > to {
+		return "", errors.New("Range is outside the text")
+	}
+
+	var builder strings.Builder
+	builder.Grow(to - from)
+
+	r.root.slice(from, to, &builder)
+	return builder.String(), nil
+}
+
+// Insert text at the provided byte offset. An error is returned when the offset
+// is outside the text's boundaries.
+func (r *Rope) Insert(offset int, text string) error {
+	if offset < 0 || offset > r.Len() {
+		return errors.New("Offset is outside the text")
+	}
+
+	if text == "" {
+		return nil
+	}
+
+	r.version++
+
+	// Extend an existing node when the result is small enough, this avoids
+	// creating lots of tiny nodes while typing.
+	if r.root != nil && len(text) < ropeMaxChunkLength {
+		if r.root.extend(offset, text) {
+			return nil
+		}
+	}
+
+	left, right := r.root.split(offset)
+	r.root = merge(merge(left, r.build(text)), right)
+
+	return nil
+}
+
+// Delete length bytes starting at the provided byte offset. An error is
+// returned when the range is outside the text's boundaries.
+func (r *Rope) Delete(offset, length int) error {
+	if offset < 0 || length < 0 || offset+length > r.Len() {
+		return errors.New("Range is outside the text")
+	}
+
+	if length == 0 {
+		return nil
+	}
+
+	r.version++
+
+	left, rest := r.root.split(offset)
+	_, right := rest.split(length)
+	r.root = merge(left, right)
+
+	return nil
+}
+
+// Instantiate a new Cursor over the Rope. The position of the cursor is
+// initialized to be at the start of the text. The cursor remains valid when
+// the Rope is edited, in which case its position is kept as is (or moved to
+// the end of the text if it no longer exists).
+func (r *Rope) NewCursor() *RopeCursor {
+	c := &RopeCursor{
+		rope: r,
+	}
+
+	c.seek(0)
+	return c
+}
+
+// Build a tree for a text, splitting it into chunks at rune boundaries.
+func (r *Rope) build(text string) *ropeNode {
+	var root *ropeNode
+
+	for len(text) > 0 {
+		length := len(text)
+		if length > ropeMaxChunkLength {
+			length = ropeMaxChunkLength
+
+			// Try not to split a multi-byte rune
+			for i := 0; i < utf8.UTFMax && !utf8.RuneStart(text[length]); i++ {
+				length--
+			}
+		}
+
+		root = merge(root, r.newNode(text[:length]))
+		text = text[length:]
+	}
+
+	return root
+}
+
+// Instantiate a new node with a random priority.
+func (r *Rope) newNode(text string) *ropeNode {
+	r.seed ^= r.seed << 13
+	r.seed ^= r.seed >> 17
+	r.seed ^= r.seed << 5
+
+	return &ropeNode{
+		text:     text,
+		priority: r.seed,
+		length:   len(text),
+	}
+}
+
+// Return the number of bytes in a subtree, allowing for nil subtrees.
+func (n *ropeNode) size() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.length
+}
+
+// Recompute the length of a node after its children have changed.
+func (n *ropeNode) update() {
+	n.length = n.left.size() + len(n.text) + n.right.size()
+}
+
+// Invoke fn for the text of every node in order.
+func (n *ropeNode) walk(fn func(text string)) {
+	if n == nil {
+		return
+	}
+
+	n.left.walk(fn)
+	fn(n.text)
+	n.right.walk(fn)
+}
+
+// Append the text in the range [from, to) of a subtree to a builder.
+func (n *ropeNode) slice(from, to int, builder *strings.Builder) {
+	if n == nil || from >= to {
+		return
+	}
+
+	leftLength := n.left.size()
+	textEnd := leftLength + len(n.text)
+
+	if from < leftLength {
+		n.left.slice(from, to, builder)
+	}
+
+	if from < textEnd && to > leftLength {
+		start := from - leftLength
+		if start < 0 {
+			start = 0
+		}
+
+		end := to - leftLength
+		if end > len(n.text) {
+			end = len(n.text)
+		}
+
+		builder.WriteString(n.text[start:end])
+	}
+
+	if to > textEnd {
+		n.right.slice(from-textEnd, to-textEnd, builder)
+	}
+}
+
+// Insert text into the node that contains the offset, but only if the result
+// does not exceed the maximum chunk length. Returns whether the text was
+// inserted.
+func (n *ropeNode) extend(offset int, text string) bool {
+	if n == nil {
+		return false
+	}
+
+	leftLength := n.left.size()
+
+	var ok bool
+	switch {
+	case offset < leftLength:
+		ok = n.left.extend(offset, text)
+	case offset <= leftLength+len(n.text):
+		if len(n.text)+len(text) > ropeMaxChunkLength {
+			return false
+		}
+
+		position := offset - leftLength
+		n.text = n.text[:position] + text + n.text[position:]
+		ok = true
+	default:
+		ok = n.right.extend(offset-leftLength-len(n.text), text)
+	}
+
+	if ok {
+		n.length += len(text)
+	}
+
+	return ok
+}
+
+// Split a subtree into two subtrees containing the text before and after the
+// provided offset. A node is split in two if the offset is inside its text.
+func (n *ropeNode) split(offset int) (*ropeNode, *ropeNode) {
+	if n == nil {
+		return nil, nil
+	}
+
+	leftLength := n.left.size()
+
+	if offset <= leftLength {
+		left, right := n.left.split(offset)
+		n.left = right
+		n.update()
+
+		return left, n
+	}
+
+	if offset >= leftLength+len(n.text) {
+		left, right := n.right.split(offset - leftLength - len(n.text))
+		n.right = left
+		n.update()
+
+		return n, right
+	}
+
+	// The offset is inside the text of this node. Both halves keep the
+	// priority of the node, which preserves the heap ordering.
+	position := offset - leftLength
+	right := &ropeNode{
+		right:    n.right,
+		text:     n.text[position:],
+		priority: n.priority,
+	}
+	right.update()
+
+	n.text = n.text[:position]
+	n.right = nil
+	n.update()
+
+	return n, right
+}
+
+// Merge two subtrees, where all text in left precedes the text in right.
+func merge(left, right *ropeNode) *ropeNode {
+	if left == nil {
+		return right
+	}
+
+	if right == nil {
+		return left
+	}
+
+	if left.priority > right.priority {
+		left.right = merge(left.right, right)
+		left.update()
+
+		return left
+	}
+
+	right.left = merge(left, right.left)
+	right.update()
+
+	return right
+}
+
+// The RopeCursor is a Cursor implementation using a Rope as its backing store.
+// The position values it uses are byte positions in the text. Setting the
+// position takes O(log n) time, while moving to the next or previous rune
+// takes amortized constant time.
+type RopeCursor struct {
+	rope    *Rope
+	version int
+
+	position int
+
+	// Path from the root to the node containing the position, and the offset
+	// of the position within the text of that node.
+	stack  []*ropeNode
+	offset int
+
+	alignment AlignmentPolicy
+}
+
+// Return the current position of the RopeCursor, represented as a byte offset
+// relative to the start of the text.
+func (c *RopeCursor) Position() int {
+	return c.position
+}
+
+// Set the policy for positions that intersect the bytes of a single rune. The
+// default policy is AlignmentReject.
+func (c *RopeCursor) SetAlignmentPolicy(policy AlignmentPolicy) {
+	c.alignment = policy
+}
+
+// Set the current RopeCursor position to the provided value. The new position
+// should be stated as a byte offset relative to the start of the text.
+// ErrPositionOutOfRange is returned when the provided position is outside the
+// text's boundaries. A value equal to the text's byte size is legal and
+// represents the end of the text.
+//
+// A position that is intersecting the bytes of a single rune is handled
+// according to the AlignmentPolicy of the RopeCursor. It is either rejected
+// with ErrMisalignedPosition, or moved to the nearest rune boundary in the
+// direction set by the policy.
+func (c *RopeCursor) SetPosition(position int) error {
+	if position < 0 || position > c.rope.Len() {
+		return ErrPositionOutOfRange
+	}
+
+	previous := c.position
+	c.seek(position)
+
+	// A position at the start of an encoded rune is aligned, which is the
+	// common case
+	if len(c.stack) > 0 {
+		if text := c.stack[len(c.stack)-1].text; c.offset < len(text) && utf8.RuneStart(text[c.offset]) {
+			return nil
+		}
+	}
+
+	// Only the bytes surrounding the position are needed to find the rune
+	// boundaries
+	from := position - (utf8.UTFMax - 1)
+	if from < 0 {
+		from = 0
+	}
+
+	to := position + utf8.UTFMax - 1
+	if to > c.rope.Len() {
+		to = c.rope.Len()
+	}
+
+	window, _ := c.rope.Slice(from, to)
+
+	aligned, err := c.alignment.align(window, position-from)
+	if err != nil {
+		// Leave the cursor where it was, which may have been moved by an
+		// edit
+		if previous > c.rope.Len() {
+			previous = c.rope.Len()
+		}
+
+		c.seek(previous)
+		return err
+	}
+
+	if from+aligned != position {
+		c.seek(from + aligned)
+	}
+
+	return nil
+}
+
+// Return the rune at the current iterator position and advance the iterator to
+// the next rune. The return value of ok is false when Next() is invoked while
+// the iterator was at the end of the text. This indicates that iteration is
+// done and there are no more runes to retrieve.
+func (c *RopeCursor) Next() (r rune, ok bool) {
+	c.sync()
+
+	if c.position >= c.rope.Len() {
+		return -1, false
+	}
+
+	if node := c.stack[len(c.stack)-1]; c.offset == len(node.text) {
+		c.successor()
+	}
+
+	text := c.stack[len(c.stack)-1].text[c.offset:]
+
+	if text[0] < utf8.RuneSelf {
+		c.offset++
+		c.position++
+
+		return rune(text[0]), true
+	}
+
+	if utf8.FullRuneInString(text) {
+		r, size := utf8.DecodeRuneInString(text)
+		c.offset += size
+		c.position += size
+
+		return r, true
+	}
+
+	// The rune continues in the next node
+	end := c.position + utf8.UTFMax
+	if end > c.rope.Len() {
+		end = c.rope.Len()
+	}
+
+	bytes, _ := c.rope.Slice(c.position, end)
+	r, size := utf8.DecodeRuneInString(bytes)
+	c.seek(c.position + size)
+
+	return r, true
+}
+
+// Return the rune at the current iterator position and retreat the iterator to
+// the previous rune. The return value of ok is false when Previous() is
+// invoked while the iterator was at the beginning of the text. This indicates
+// that iteration is done and there are no more runes to retrieve.
+func (c *RopeCursor) Previous() (r rune, ok bool) {
+	c.sync()
+
+	if c.position <= 0 {
+		return -1, false
+	}
+
+	if c.offset == 0 {
+		c.predecessor()
+	}
+
+	text := c.stack[len(c.stack)-1].text[:c.offset]
+
+	if b := text[len(text)-1]; b < utf8.RuneSelf {
+		c.offset--
+		c.position--
+
+		return rune(b), true
+	}
+
+	if len(text) >= utf8.UTFMax || c.position == len(text) {
+		r, size := utf8.DecodeLastRuneInString(text)
+		c.offset -= size
+		c.position -= size
+
+		return r, true
+	}
+
+	// The rune may start in the previous node
+	start := c.position - utf8.UTFMax
+	if start < 0 {
+		start = 0
+	}
+
+	bytes, _ := c.rope.Slice(start, c.position)
+	r, size := utf8.DecodeLastRuneInString(bytes)
+	c.seek(c.position - size)
+
+	return r, true
+}
+
+// Reposition the cursor if the Rope was edited since the last operation.
+func (c *RopeCursor) sync() {
+	if c.version == c.rope.version {
+		return
+	}
+
+	position := c.position
+	if position > c.rope.Len() {
+		position = c.rope.Len()
+	}
+
+	c.seek(position)
+}
+
+// Move the cursor to a position by descending the tree from the root. A
+// position at the end of a node's text is represented by that node, unless it
+// is the start of the text.
+func (c *RopeCursor) seek(position int) {
+	c.version = c.rope.version
+	c.position = position
+	c.stack = c.stack[:0]
+	c.offset = 0
+
+	node := c.rope.root
+	for node != nil {
+		c.stack = append(c.stack, node)
+		leftLength := node.left.size()
+
+		if position < leftLength || (position == leftLength && node.left != nil) {
+			node = node.left
+		} else if position <= leftLength+len(node.text) {
+			c.offset = position - leftLength
+			return
+		} else {
+			position -= leftLength + len(node.text)
+			node = node.right
+		}
+	}
+}
+
+// Move the cursor to the start of the next node in order.
+func (c *RopeCursor) successor() {
+	node := c.stack[len(c.stack)-1]
+
+	if node.right != nil {
+		for node = node.right; node != nil; node = node.left {
+			c.stack = append(c.stack, node)
+		}
+	} else {
+		for {
+			child := c.stack[len(c.stack)-1]
+			c.stack = c.stack[:len(c.stack)-1]
+
+			if c.stack[len(c.stack)-1].left == child {
+				break
+			}
+		}
+	}
+
+	c.offset = 0
+}
+
+// Move the cursor to the end of the previous node in order.
+func (c *RopeCursor) predecessor() {
+	node := c.stack[len(c.stack)-1]
+
+	if node.left != nil {
+		for node = node.left; node != nil; node = node.right {
+			c.stack = append(c.stack, node)
+		}
+	} else {
+		for {
+			child := c.stack[len(c.stack)-1]
+			c.stack = c.stack[:len(c.stack)-1]
+
+			if c.stack[len(c.stack)-1].right == child {
+				break
+			}
+		}
+	}
+
+	c.offset = len(c.stack[len(c.stack)-1].text)
+}
@@ -0,0 +1,184 @@
+package rbbi
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRopeEdits(t *testing.T) {
+	rope := NewRope("hello world")
+
+	if err := rope.Insert(5, ","); err != nil {
+		t.Error(err)
+	}
+
+	if err := rope.Delete(6, 6); err != nil {
+		t.Error(err)
+	}
+
+	if err := rope.Insert(6, " there"); err != nil {
+		t.Error(err)
+	}
+
+	if str := rope.String(); str != "hello, there" {
+		t.Errorf("Invalid rope contents %q", str)
+	}
+
+	if slice, err := rope.Slice(7, 12); err != nil || slice != "there" {
+		t.Errorf("Invalid rope slice %q", slice)
+	}
+
+	if err := rope.Insert(13, "!"); err == nil {
+		t.Error("Insert beyond end of rope did not fail")
+	}
+
+	if err := rope.Delete(10, 3); err == nil {
+		t.Error("Delete beyond end of rope did not fail")
+	}
+}
+
+func TestRopeRandomEdits(t *testing.T) {
+	fragments := []string{"a", "bc", " ", "é", "🐨", "日本語", strings.Repeat("xyz", 500), strings.Repeat("🏴‍☠️", 300)}
+	random := rand.New(rand.NewSource(1))
+
+	str := ""
+	rope := NewRope(str)
+
+	for i := 0; i < 1000; i++ {
+		if random.Intn(3) == 0 && len(str) > 0 {
+			offset := random.Intn(len(str))
+			length := random.Intn(len(str) - offset)
+
+			str = str[:offset] + str[offset+length:]
+			if err := rope.Delete(offset, length); err != nil {
+				t.Fatal(err)
+			}
+		} else {
+			offset := random.Intn(len(str) + 1)
+			fragment := fragments[random.Intn(len(fragments))]
+
+			str = str[:offset] + fragment + str[offset:]
+			if err := rope.Insert(offset, fragment); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if rope.Len() != len(str) {
+			t.Fatalf("Invalid rope length %v, expected %v", rope.Len(), len(str))
+		}
+	}
+
+	if rope.String() != str {
+		t.Fatal("Invalid rope contents after random edits")
+	}
+
+	// Compare cursor iteration in both directions with a StringCursor,
+	// including runes that are split across nodes by byte-level edits.
+	cursor := rope.NewCursor()
+	reference := NewStringCursor(str)
+
+	for {
+		r, ok := cursor.Next()
+		expected, expectedOk := reference.Next()
+
+		if r != expected || ok != expectedOk || cursor.Position() != reference.Position() {
+			t.Fatalf("Invalid rune %v at position %v", r, cursor.Position())
+		}
+
+		if !ok {
+			break
+		}
+	}
+
+	for {
+		r, ok := cursor.Previous()
+		expected, expectedOk := reference.Previous()
+
+		if r != expected || ok != expectedOk || cursor.Position() != reference.Position() {
+			t.Fatalf("Invalid rune %v at position %v", r, cursor.Position())
+		}
+
+		if !ok {
+			break
+		}
+	}
+}
+
+func TestRopeCursorSetPosition(t *testing.T) {
+	rope := NewRope(strings.Repeat("a🐨", 1000))
+	cursor := rope.NewCursor()
+
+	if err := cursor.SetPosition(2500); err != nil {
+		t.Error(err)
+	}
+
+	if r, ok := cursor.Next(); !ok || r != 'a' {
+		t.Errorf("Invalid rune %q after SetPosition", r)
+	}
+
+	if err := cursor.SetPosition(-1); err == nil {
+		t.Error("SetPosition before start of rope did not fail")
+	}
+
+	if err := cursor.SetPosition(rope.Len() + 1); err == nil {
+		t.Error("SetPosition beyond end of rope did not fail")
+	}
+}
+
+func TestRopeCursorAfterEdit(t *testing.T) {
+	rope := NewRope("hello world")
+	cursor := rope.NewCursor()
+	cursor.SetPosition(6)
+
+	rope.Insert(0, ">> ")
+
+	// The cursor keeps its position but sees the edited text
+	if r, ok := cursor.Next(); !ok || r != 'l' {
+		t.Errorf("Invalid rune %q after edit", r)
+	}
+
+	rope.Delete(0, rope.Len())
+
+	if _, ok := cursor.Next(); ok {
+		t.Error("Next was ok after deleting all text")
+	}
+}
+
+func TestRopeBreakIteration(t *testing.T) {
+	str := strings.Repeat("The quick 🦊 jumps over the lazy dog. ", 200)
+
+	rope := NewRope("")
+	for i := 0; i < len(str); i += 37 {
+		end := i + 37
+		if end > len(str) {
+			end = len(str)
+		}
+
+		rope.Insert(rope.Len(), str[i:end])
+	}
+
+	for _, constructor := range []func() *RBBI{NewCharacterRBBI, NewWordRBBI, NewLineRBBI, NewSentenceRBBI} {
+		iter := constructor()
+		iter.SetCursor(rope.NewCursor())
+
+		boundaries := []int{0}
+		for {
+			pos, ok := iter.Next()
+			if !ok {
+				break
+			}
+
+			boundaries = append(boundaries, pos)
+		}
+
+		if !reflect.DeepEqual(boundaries, allBoundaries(constructor(), str)) {
+			t.Error("Invalid boundaries over rope")
+		}
+
+		if pos, ok := iter.Preceding(len(str) / 2); !ok || pos >= len(str)/2 {
+			t.Errorf("Invalid preceding boundary %v over rope", pos)
+		}
+	}
+}
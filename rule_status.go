@@ -0,0 +1,48 @@
+package rbbi
+
+// Rule status values returned by RBBI.RuleStatus(). The values are grouped in
+// ranges, where each kind of break has a start value and an exclusive limit
+// value. Rules may return any value within the range, so comparisons should
+// be done using the start and limit values. These are identical to the values
+// used by ICU.
+const (
+	// Word breaks that do not fit into any of the other categories, such as
+	// spaces and most punctuation.
+	RuleStatusWordNone      = 0
+	RuleStatusWordNoneLimit = 100
+
+	// Word breaks following words that appear to be numbers.
+	RuleStatusWordNumber      = 100
+	RuleStatusWordNumberLimit = 200
+
+	// Word breaks following words containing letters, excluding hiragana,
+	// katakana or ideographic characters.
+	RuleStatusWordLetter      = 200
+	RuleStatusWordLetterLimit = 300
+
+	// Word breaks following words containing kana characters.
+	RuleStatusWordKana      = 300
+	RuleStatusWordKanaLimit = 400
+
+	// Word breaks following words containing ideographic characters.
+	RuleStatusWordIdeo      = 400
+	RuleStatusWordIdeoLimit = 500
+
+	// Line breaks at positions where a break is permitted but not required.
+	RuleStatusLineSoft      = 0
+	RuleStatusLineSoftLimit = 100
+
+	// Line breaks that are required, e.g. after a newline.
+	RuleStatusLineHard      = 100
+	RuleStatusLineHardLimit = 200
+
+	// Sentence breaks following a sentence ending with a terminator such as a
+	// period or question mark.
+	RuleStatusSentenceTerm      = 0
+	RuleStatusSentenceTermLimit = 100
+
+	// Sentence breaks following a sentence ending with a separator such as a
+	// newline, without a terminator.
+	RuleStatusSentenceSep      = 100
+	RuleStatusSentenceSepLimit = 200
+)
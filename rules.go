@@ -0,0 +1,137 @@
+package rbbi
+
+import (
+	_ "embed"
+	"sync"
+)
+
+// The Rules struct represents an immutable set of break rules, consisting of
+// the state tables and lookup tables for one kind of break. Rules are safe for
+// concurrent use by multiple goroutines, and can be used to cheaply
+// instantiate any number of break iterators.
+//
+// A break iterator (RBBI) holds per-iteration state such as its Cursor, and
+// should therefore not be shared between goroutines. Instead, every goroutine
+// should use its own break iterator, instantiated using NewRBBI() or Clone().
+type Rules struct {
+	// The encoded tables, which are decoded into data when the rules are
+	// first used. This is a pointer, because copying the embedded string
+	// would require an init function that links the tables of every kind of
+	// break.
+	encoded *string
+
+	data *rbbiData
+
+	// The tables are decoded and checked for consistency once, before they
+	// are first used
+	validateOnce sync.Once
+	err          error
+
+	// The categories of the Latin-1 code points, which are looked up once
+	// during validation to avoid the trie lookup for most runes
+	latin1Categories [256]uint16
+}
+
+// The encoded tables of every kind of break, in the format described in
+// rbbi_data_encoding.go. They are generated from the text files in data/ by
+// gen_tables.go.
+//
+//go:generate go run gen_tables.go
+var (
+	//go:embed data/character.rbbi
+	rbbiCharacterData string
+
+	//go:embed data/line.rbbi
+	rbbiLineData string
+
+	//go:embed data/sentence.rbbi
+	rbbiSentenceData string
+
+	//go:embed data/word.rbbi
+	rbbiWordData string
+)
+
+// The rules and their encoded tables are statically initialized, so the
+// linker leaves out the tables of every kind of break that a program doesn't
+// use. Nothing that is always linked, such as an init function or a map of
+// the rule sets, may refer to all of them. This is checked by
+// TestDeadCodeElimination.
+var (
+	characterRules = Rules{encoded: &rbbiCharacterData}
+	lineRules      = Rules{encoded: &rbbiLineData}
+	sentenceRules  = Rules{encoded: &rbbiSentenceData}
+	wordRules      = Rules{encoded: &rbbiWordData}
+)
+
+// Return the rules for detecting character (grapheme cluster) breaks.
+func CharacterRules() *Rules {
+	return &characterRules
+}
+
+// Return the rules for detecting line breaks (for use with word wrapping).
+func LineRules() *Rules {
+	return &lineRules
+}
+
+// Return the rules for detecting sentence breaks.
+func SentenceRules() *Rules {
+	return &sentenceRules
+}
+
+// Return the rules for detecting word boundary breaks.
+func WordRules() *Rules {
+	return &wordRules
+}
+
+// Instantiate a new break iterator using the rules. Errors in the tables are
+// reported by the break iterator once it is used.
+func (r *Rules) NewRBBI() *RBBI {
+	iter := &RBBI{
+		rules: r,
+	}
+
+	// The size of the lookahead results can only be trusted when the tables
+	// are consistent
+	if r.validate() == nil {
+		iter.lookaheadMatches = make([]int, r.data.forwardTable.lookaheadResultsSize)
+	}
+
+	iter.data = r.data
+	return iter
+}
+
+// Return the result of decoding the tables and checking their consistency.
+// This also prepares the Latin-1 category table. When decoding fails the
+// rules get empty tables, so that they can still be accessed.
+func (r *Rules) validate() error {
+	r.validateOnce.Do(func() {
+		if r.data == nil {
+			r.data, r.err = decodeRBBIData(*r.encoded)
+			if r.err != nil {
+				r.data = &rbbiData{}
+				return
+			}
+		}
+
+		r.err = r.data.validate()
+		if r.err != nil {
+			return
+		}
+
+		for c := range r.latin1Categories {
+			category, err := r.data.trie.get(rune(c))
+			if err == nil && category >= r.data.categoryCount {
+				err = corruptTableError("category %v of rune %U is out of range", category, c)
+			}
+
+			if err != nil {
+				r.err = err
+				return
+			}
+
+			r.latin1Categories[c] = uint16(category)
+		}
+	})
+
+	return r.err
+}
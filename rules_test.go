@@ -0,0 +1,100 @@
+package rbbi
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRulesNewRBBI(t *testing.T) {
+	if NewWordRBBI().Rules() != WordRules() {
+		t.Error("Break iterator does not use the shared rules")
+	}
+
+	iter := CharacterRules().NewRBBI()
+	iter.SetCursor(NewStringCursor("🐨🏴‍☠️❤️‍🔥🥕"))
+
+	if pos, ok := iter.Next(); !ok || pos != 4 {
+		t.Errorf("Invalid next break position %v", pos)
+	}
+}
+
+func TestClone(t *testing.T) {
+	str := "Hello world. This is a test."
+
+	iter := NewWordRBBI()
+	iter.SetCursor(NewStringCursor(str))
+	iter.Next()
+
+	traced := 0
+	iter.SetTracer(func(step TraceStep) {
+		traced++
+	})
+
+	clone := iter.Clone()
+	if clone.Rules() != iter.Rules() {
+		t.Error("Clone does not share the rules")
+	}
+
+	// Iterating the clone should not affect the original
+	clone.SetCursor(NewStringCursor(str))
+	clone.Next()
+	clone.Next()
+
+	if iter.Current() != 5 {
+		t.Errorf("Iterating the clone moved the original to %v", iter.Current())
+	}
+
+	if traced != 0 {
+		t.Errorf("Iterating the clone called the Tracer of the original %v times", traced)
+	}
+}
+
+func testParallel(t *testing.T, newIterator func() *RBBI) {
+	str := strings.Repeat("The quick (“brown”) fox can’t jump 32.3 feet, right? 🐨🏴‍☠️ 日本語\n", 50)
+	expected := allBoundaries(newIterator(), str)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			iter := newIterator()
+
+			for j := 0; j < 10; j++ {
+				if boundaries := allBoundaries(iter, str); !reflect.DeepEqual(boundaries, expected) {
+					t.Error("Invalid boundaries in parallel iteration")
+					return
+				}
+
+				iter.SetCursor(NewStringCursor(str))
+				iter.Preceding(len(str) / 2)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestParallelSharedRules(t *testing.T) {
+	for _, rules := range []*Rules{CharacterRules(), LineRules(), SentenceRules(), WordRules()} {
+		testParallel(t, rules.NewRBBI)
+	}
+}
+
+func TestParallelClones(t *testing.T) {
+	for _, iter := range []*RBBI{NewCharacterRBBI(), NewLineRBBI(), NewSentenceRBBI(), NewWordRBBI()} {
+		testParallel(t, iter.Clone)
+	}
+}
+
+func TestRulesValid(t *testing.T) {
+	for _, rules := range []*Rules{CharacterRules(), LineRules(), SentenceRules(), WordRules()} {
+		if err := rules.validate(); err != nil {
+			t.Errorf("Invalid rules: %v", err)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package rbbi
+
+import (
+	"sort"
+	"strings"
+)
+
+// Return the matches that start and end at a break of the text, like ICU's
+// StringSearch does with a break iterator. Using a word break iterator gives
+// whole word matches, and using a character break iterator rejects matches
+// that split a grapheme cluster, such as an "e" that is followed by a
+// combining accent.
+//
+// Every match is a slice of which the first two elements are the byte offsets
+// of its start and end, as returned by the FindAllStringIndex() and
+// FindAllStringSubmatchIndex() functions of the regexp package. The matches
+// that are kept are returned in a new slice, in their original order.
+//
+// The breaks are found using AppendBoundaries(), so the Cursor of the break
+// iterator is not used. When the breaks can't be found because of a corrupt
+// table nil is returned, and the error can be retrieved using Err().
+//
+// ICU splits runs of ideographs, kana, Hangul and complex scripts such as
+// Thai into words using a dictionary, which the rules don't include. Matches
+// within such a run may therefore start and end at any grapheme cluster
+// boundary, so that e.g. the word 日本 is found in 日本語.
+func (r *RBBI) FilterMatches(text string, matches [][]int) [][]int {
+	boundaries, ok := r.searchBoundaries(text)
+	if !ok {
+		return nil
+	}
+
+	var result [][]int
+	for _, match := range matches {
+		if len(match) >= 2 && isBoundary(boundaries, match[0]) && isBoundary(boundaries, match[1]) {
+			result = append(result, match)
+		}
+	}
+
+	return result
+}
+
+// Return the start and end byte offsets of the occurrences of a substring that
+// start and end at a break of the text, like FilterMatches(). At most n
+// matches are returned, or all of them when n is negative. Matches don't
+// overlap, but an occurrence that is rejected doesn't hide an overlapping one
+// that starts after it, as it would when filtering the results of a regular
+// expression. An empty substring has no matches.
+func (r *RBBI) FindAllStringIndex(text, substr string, n int) [][]int {
+	if substr == "" || n == 0 {
+		return nil
+	}
+
+	boundaries, ok := r.searchBoundaries(text)
+	if !ok {
+		return nil
+	}
+
+	var result [][]int
+	for position := 0; n < 0 || len(result) < n; {
+		index := strings.Index(text[position:], substr)
+		if index < 0 {
+			break
+		}
+
+		start := position + index
+		end := start + len(substr)
+
+		if isBoundary(boundaries, start) && isBoundary(boundaries, end) {
+			result = append(result, []int{start, end})
+			position = end
+		} else {
+			position = start + 1
+		}
+	}
+
+	return result
+}
+
+// Return the breaks of the text, including the dictionary breaks, and whether
+// all of them were found. The error of an incomplete list is recorded in the
+// break iterator.
+func (r *RBBI) searchBoundaries(text string) ([]int, bool) {
+	boundaries := r.AppendBoundaries(nil, text)
+	if len(boundaries) == 0 || boundaries[len(boundaries)-1] != len(text) {
+		return boundaries, false
+	}
+
+	dictionary, ok := r.dictionaryBoundaries(text)
+	if !ok {
+		return nil, false
+	}
+
+	if len(dictionary) > 0 {
+		boundaries = append(boundaries, dictionary...)
+		sort.Ints(boundaries)
+	}
+
+	return boundaries, true
+}
+
+// Return the positions between two characters in the dictionary categories of
+// the rules that are grapheme cluster boundaries, which stand in for the word
+// breaks that ICU would find using a dictionary. Errors are recorded in the
+// break iterator.
+func (r *RBBI) dictionaryBoundaries(text string) ([]int, bool) {
+	var candidates []int
+
+	previous := false
+	for position, c := range text {
+		category, err := r.category(c)
+		if err != nil {
+			r.err = err
+			return nil, false
+		}
+
+		current := category >= r.data.forwardTable.dictCategoriesStart
+		if previous && current {
+			candidates = append(candidates, position)
+		}
+
+		previous = current
+	}
+
+	if len(candidates) == 0 {
+		return nil, true
+	}
+
+	characters := NewCharacterRBBI()
+	graphemes := characters.AppendBoundaries(nil, text)
+	if characters.Err() != nil {
+		r.err = characters.Err()
+		return nil, false
+	}
+
+	var result []int
+	for _, candidate := range candidates {
+		if isBoundary(graphemes, candidate) {
+			result = append(result, candidate)
+		}
+	}
+
+	return result, true
+}
+
+// Return whether a sorted list of breaks contains a position.
+func isBoundary(boundaries []int, position int) bool {
+	i := sort.SearchInts(boundaries, position)
+	return i < len(boundaries) && boundaries[i] == position
+}
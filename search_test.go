@@ -0,0 +1,110 @@
+package rbbi
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestFindAllStringIndex(t *testing.T) {
+	tests := []struct {
+		iter     *RBBI
+		text     string
+		substr   string
+		n        int
+		expected [][]int
+	}{
+		// Whole words only
+		{NewWordRBBI(), "the other theme, the end", "the", -1, [][]int{{0, 3}, {17, 20}}},
+		{NewWordRBBI(), "the other theme, the end", "the", 1, [][]int{{0, 3}}},
+		{NewWordRBBI(), "the other theme, the end", "the", 0, nil},
+
+		// An e followed by a combining accent is not a word or a grapheme
+		{NewWordRBBI(), "cafe\u0301 cafe", "cafe", -1, [][]int{{7, 11}}},
+		{NewCharacterRBBI(), "cafe\u0301 cafe", "e", -1, [][]int{{10, 11}}},
+		{NewCharacterRBBI(), "cafe\u0301 cafe", "e\u0301", -1, [][]int{{3, 6}}},
+
+		// Runs of characters that ICU splits into words using a dictionary
+		// match at any grapheme cluster boundary
+		{NewWordRBBI(), "日本語 日本", "日本", -1, [][]int{{0, 6}, {10, 16}}},
+		{NewWordRBBI(), "日本語 日本", "本語", -1, [][]int{{3, 9}}},
+		{NewWordRBBI(), "カタカナとひらがな", "ひらがな", -1, [][]int{{15, 27}}},
+		{NewWordRBBI(), "한국어 사전", "한국", -1, [][]int{{0, 6}}},
+		{NewWordRBBI(), "ภาษาไทย", "ไทย", -1, [][]int{{12, 21}}},
+		{NewCharacterRBBI(), "日本語 日本", "日本", -1, [][]int{{0, 6}, {10, 16}}},
+
+		// The dictionary breaks don't split grapheme clusters, or words
+		// of other scripts
+		{NewWordRBBI(), "ที่นี่", "ท", -1, nil},
+		{NewWordRBBI(), "ที่นี่", "นี่", -1, [][]int{{9, 18}}},
+		{NewWordRBBI(), "ไทยabc日本", "ab", -1, nil},
+		{NewWordRBBI(), "日本abc", "日本", -1, [][]int{{0, 6}}},
+
+		// A rejected occurrence doesn't hide an overlapping one
+		{NewWordRBBI(), "aaa aa", "aa", -1, [][]int{{4, 6}}},
+		{NewCharacterRBBI(), "aaa", "aa", -1, [][]int{{0, 2}}},
+
+		{NewWordRBBI(), "abc", "", -1, nil},
+		{NewWordRBBI(), "", "abc", -1, nil},
+	}
+
+	for _, test := range tests {
+		if matches := test.iter.FindAllStringIndex(test.text, test.substr, test.n); !reflect.DeepEqual(matches, test.expected) {
+			t.Errorf("Invalid matches %v of %q in %q, expected %v", matches, test.substr, test.text, test.expected)
+		}
+
+		if test.iter.Err() != nil {
+			t.Errorf("Unexpected error %v", test.iter.Err())
+		}
+	}
+}
+
+func TestFilterMatches(t *testing.T) {
+	text := "Re\u0301sume\u0301: re-use the re\u0301sume\u0301, or resume?"
+
+	iter := NewWordRBBI()
+	matches := regexp.MustCompile(`re\x{301}?sume`).FindAllStringIndex(text, -1)
+	if len(matches) != 2 {
+		t.Fatalf("Unexpected regexp matches %v", matches)
+	}
+
+	// The match in "re\u0301sume\u0301" ends before the combining accent
+	expected := matches[1:]
+	if filtered := iter.FilterMatches(text, matches); !reflect.DeepEqual(filtered, expected) {
+		t.Errorf("Invalid filtered matches %v of %v, expected %v", filtered, matches, expected)
+	}
+
+	// Submatches are kept
+	submatches := regexp.MustCompile(`(\w+)-(\w+)`).FindAllStringSubmatchIndex(text, -1)
+	if filtered := iter.FilterMatches(text, submatches); !reflect.DeepEqual(filtered, submatches) {
+		t.Errorf("Invalid filtered submatches %v, expected %v", filtered, submatches)
+	}
+
+	// Matches outside the text and incomplete matches are rejected
+	if filtered := iter.FilterMatches("abc", [][]int{{-1, 3}, {0, 4}, {0}, nil, {0, 3}}); !reflect.DeepEqual(filtered, [][]int{{0, 3}}) {
+		t.Errorf("Invalid filtered matches %v", filtered)
+	}
+
+	if iter.Err() != nil {
+		t.Errorf("Unexpected error %v", iter.Err())
+	}
+}
+
+func TestFilterMatchesCorrupt(t *testing.T) {
+	data := copyData(decodedData(&characterRules))
+	setNextStates(&data.forwardTable, int(rbbiStateStart), 200)
+
+	iter := newBrokenRBBI(data, "")
+	if matches := iter.FilterMatches("abc", [][]int{{0, 3}}); matches != nil {
+		t.Errorf("Matches %v were found with a corrupt state table", matches)
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+
+	iter = newBrokenRBBI(data, "")
+	if matches := iter.FindAllStringIndex("abc", "abc", -1); matches != nil {
+		t.Errorf("Matches %v were found with a corrupt state table", matches)
+	}
+
+	expectError(t, iter, ErrCorruptTable)
+}
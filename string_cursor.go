@@ -1,15 +1,15 @@
 package rbbi
 
 import (
-	"errors"
 	"unicode/utf8"
 )
 
 // The StringCursor is a Cursor implementation using a regular Go string as its
 // backing store. The position values it uses are byte positions in the string.
 type StringCursor struct {
-	text     string
-	position int
+	text      string
+	position  int
+	alignment AlignmentPolicy
 }
 
 // Instantiate a new StringCursor using the provided string. The position of
@@ -27,22 +27,33 @@ func (c *StringCursor) Position() int {
 	return c.position
 }
 
+// Set the policy for positions that intersect the bytes of a single rune. The
+// default policy is AlignmentReject.
+func (c *StringCursor) SetAlignmentPolicy(policy AlignmentPolicy) {
+	c.alignment = policy
+}
+
 // Set the current StringCursor position to the provided value. The new
 // position should be stated as a byte offset relative to the start of the
-// string. The position may not be a byte offset that is intersecting the bytes
-// of a single rune. An error is returned when the provided position is outside
-// the string's boundaries. A value equal to the string's byte size is legal
-// and represents the end of the string.
+// string. ErrPositionOutOfRange is returned when the provided position is
+// outside the string's boundaries. A value equal to the string's byte size is
+// legal and represents the end of the string.
+//
+// A position that is intersecting the bytes of a single rune is handled
+// according to the AlignmentPolicy of the StringCursor. It is either rejected
+// with ErrMisalignedPosition, or moved to the nearest rune boundary in the
+// direction set by the policy.
 func (c *StringCursor) SetPosition(position int) error {
-	// Negative positions are invalid
-	if position < 0 {
-		return errors.New("Position can not be negative")
+	// Setting the position to len(c.text) is valid (this is the end of string
+	// position), but setting it beyond is an error. Negative positions are
+	// invalid too.
+	if position < 0 || position > len(c.text) {
+		return ErrPositionOutOfRange
 	}
 
-	// Setting the position to len(c.text) is valid (this is the end of string
-	// position), but setting it beyond is an error.
-	if position > len(c.text) {
-		return errors.New("Position can not be beyond the end of the string")
+	position, err := c.alignment.align(c.text, position)
+	if err != nil {
+		return err
 	}
 
 	c.position = position
@@ -0,0 +1,84 @@
+package rbbi
+
+import (
+	"fmt"
+	"io"
+)
+
+// The state table used by a step of the state machine.
+type TraceTable int
+
+const (
+	// The forward table, used by Next() and to find the breaks following
+	// a safe point
+	TraceForward TraceTable = iota
+
+	// The safe reverse table, used to move backwards to a safe point
+	TraceReverse
+)
+
+func (t TraceTable) String() string {
+	if t == TraceReverse {
+		return "reverse"
+	}
+
+	return "forward"
+}
+
+// A TraceStep describes a single transition of the state machine, similar to
+// the output of ICU's RBBI_DEBUG tracing.
+type TraceStep struct {
+	Table TraceTable
+
+	// The number of the step within a run of the state machine, starting at
+	// 0 for the first rune of a Next() call or safe point search
+	Index int
+
+	// The Cursor position after reading the rune
+	Position int
+
+	// The rune, or -1 for the pseudo categories used at the start or the end
+	// of the text
+	Rune rune
+
+	Category  int
+	State     int
+	NextState int
+
+	// The accepting and lookahead values of the row of the next state
+	Accepting int
+	Lookahead int
+
+	// The rule status index of the break iterator after the step
+	RuleStatusIndex int
+}
+
+// A Tracer is called for every step of the state machines of a break
+// iterator that has it assigned using SetTracer().
+type Tracer func(step TraceStep)
+
+// Assign a Tracer to the break iterator, or remove it by passing nil. Only
+// iteration using a Cursor is traced, AppendBoundaries() is not.
+func (r *RBBI) SetTracer(tracer Tracer) {
+	r.tracer = tracer
+}
+
+// Return a Tracer that writes a line of text for every step to w, and a
+// header line at the start of every run of a state machine. Write errors are
+// ignored.
+func NewTextTracer(w io.Writer) Tracer {
+	return func(step TraceStep) {
+		if step.Index == 0 {
+			fmt.Fprintf(w, "%v\n%8v  %-8v  %4v  %5v -> %-5v  %6v  %9v  %6v\n", step.Table, "position", "rune", "cat", "state", "next", "accept", "lookahead", "status")
+		}
+
+		r := "{bof}"
+		if step.Rune >= 0 {
+			r = fmt.Sprintf("%U", step.Rune)
+		} else if step.Category == 1 {
+			r = "{eof}"
+		}
+
+		fmt.Fprintf(w, "%8v  %-8v  %4v  %5v -> %-5v  %6v  %9v  %6v\n", step.Position, r, step.Category, step.State, step.NextState, step.Accepting, step.Lookahead, step.RuleStatusIndex)
+	}
+}
@@ -0,0 +1,83 @@
+package rbbi
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTracer(t *testing.T) {
+	var steps []TraceStep
+
+	iter := NewWordRBBI()
+	iter.SetCursor(NewStringCursor("ab c"))
+	iter.SetTracer(func(step TraceStep) {
+		steps = append(steps, step)
+	})
+
+	if pos, _ := iter.Next(); pos != 2 {
+		t.Fatalf("Invalid break %v while tracing", pos)
+	}
+
+	// The state machine reads the space before it stops
+	if len(steps) != 3 {
+		t.Fatalf("Invalid number of steps %v", len(steps))
+	}
+
+	for i, step := range steps {
+		if step.Table != TraceForward || step.Index != i || step.Position != i+1 || step.Rune != rune("ab "[i]) {
+			t.Errorf("Invalid step %+v", step)
+		}
+
+		if i > 0 && step.State != steps[i-1].NextState {
+			t.Errorf("Step %v does not start in the state that step %v ended in", i, i-1)
+		}
+	}
+
+	if steps[0].State != int(rbbiStateStart) || steps[2].NextState != int(rbbiStateStop) {
+		t.Errorf("Invalid start and stop states in %+v", steps)
+	}
+
+	if steps[1].Accepting != int(rbbiAcceptingUnconditional) || steps[2].RuleStatusIndex != int(iter.ruleStatusIndex) {
+		t.Errorf("Invalid accepting value or rule status index in %+v", steps)
+	}
+
+	// Preceding uses the reverse table to find a safe point first
+	steps = nil
+	iter.Preceding(4)
+
+	if len(steps) == 0 || steps[0].Table != TraceReverse || steps[0].Position != 3 || steps[0].Rune != 'c' {
+		t.Errorf("Invalid reverse steps %+v", steps)
+	}
+
+	// Without a tracer nothing is reported
+	iter.SetTracer(nil)
+	steps = nil
+	iter.Following(0)
+
+	if len(steps) != 0 {
+		t.Errorf("Steps were reported after removing the tracer")
+	}
+}
+
+func TestTextTracer(t *testing.T) {
+	var b strings.Builder
+
+	iter := NewSentenceRBBI()
+	iter.SetCursor(NewStringCursor("Hi."))
+	iter.SetTracer(NewTextTracer(&b))
+	iter.Next()
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if len(lines) != 7 || lines[0] != "forward" || !strings.Contains(lines[1], "lookahead") {
+		t.Fatalf("Invalid trace output\n%v", b.String())
+	}
+
+	// The sentence rules start with the {bof} pseudo category
+	if !strings.Contains(lines[2], "{bof}") || !strings.Contains(lines[6], "{eof}") {
+		t.Errorf("The start and end of the text are not traced\n%v", b.String())
+	}
+
+	if fields := strings.Fields(lines[3]); fields[0] != "1" || fields[1] != "U+0048" || fields[4] != "->" {
+		t.Errorf("Invalid trace line %q", lines[3])
+	}
+}
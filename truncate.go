@@ -0,0 +1,154 @@
+package rbbi
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Truncate a string to at most maxGraphemes grapheme clusters. The string is
+// only cut at character (grapheme cluster) breaks, so combining marks and
+// emoji sequences are never split. If the string needs to be truncated the
+// tail (e.g. "…") is appended. The tail counts towards maxGraphemes, and is
+// omitted when it does not fit by itself.
+func Truncate(text string, maxGraphemes int, tail string) string {
+	offset, truncated := truncateGraphemes(text, maxGraphemes, tail)
+	if !truncated {
+		return text
+	}
+
+	if offset < 0 {
+		offset, _ = truncateGraphemes(text, maxGraphemes, "")
+		return text[:offset]
+	}
+
+	return text[:offset] + tail
+}
+
+// Truncate a string to at most maxGraphemes grapheme clusters like
+// Truncate(), but prefer cutting at a word break. When the previous word break
+// is at most threshold grapheme clusters before the cut, the string is cut at
+// the word break instead and trailing white space is removed.
+func TruncateWords(text string, maxGraphemes int, tail string, threshold int) string {
+	offset, truncated := truncateGraphemes(text, maxGraphemes, tail)
+	if !truncated {
+		return text
+	}
+
+	if offset < 0 {
+		tail = ""
+		offset, _ = truncateGraphemes(text, maxGraphemes, tail)
+	}
+
+	// Find the last word break at or before the cut
+	words := NewWordRBBI()
+	words.SetCursor(NewStringCursor(text))
+
+	wordBreak := 0
+	for {
+		position, ok := words.Next()
+		if !ok || position > offset {
+			break
+		}
+
+		wordBreak = position
+	}
+
+	// Only back off when there is something left of the text, and when the
+	// word break is close enough.
+	if wordBreak > 0 && GraphemeCount(text[wordBreak:offset]) <= threshold {
+		trimmed := wordBreak
+		for trimmed > 0 {
+			r, size := utf8.DecodeLastRuneInString(text[:trimmed])
+			if !unicode.IsSpace(r) {
+				break
+			}
+
+			trimmed -= size
+		}
+
+		if trimmed > 0 {
+			offset = trimmed
+		}
+	}
+
+	return text[:offset] + tail
+}
+
+// Truncate a string so that it occupies at most maxWidth terminal cells,
+// measuring ambiguous characters as narrow. See WidthMode.TruncateWidth() for
+// details.
+func TruncateWidth(text string, maxWidth int, tail string) string {
+	return WidthModeNarrow.TruncateWidth(text, maxWidth, tail)
+}
+
+// Truncate a string so that it occupies at most maxWidth terminal cells. The
+// string is only cut at character (grapheme cluster) breaks. If the string
+// needs to be truncated the tail is appended. The width of the tail counts
+// towards maxWidth, and the tail is omitted when it does not fit by itself.
+func (m WidthMode) TruncateWidth(text string, maxWidth int, tail string) string {
+	if m.StringWidth(text) <= maxWidth {
+		return text
+	}
+
+	budget := maxWidth - m.StringWidth(tail)
+	if budget < 0 {
+		budget = maxWidth
+		tail = ""
+	}
+
+	iter := NewCharacterRBBI()
+	iter.SetCursor(NewStringCursor(text))
+
+	width := 0
+	start := 0
+	for {
+		end, ok := iter.Next()
+		if !ok {
+			break
+		}
+
+		width += m.GraphemeWidth(text[start:end])
+		if width > budget {
+			break
+		}
+
+		start = end
+	}
+
+	return text[:start] + tail
+}
+
+// Find the byte offset at which text must be cut to leave room for the tail
+// within maxGraphemes grapheme clusters. The value of truncated is false when
+// the text fits as is. A negative offset is returned when the tail does not
+// fit by itself.
+func truncateGraphemes(text string, maxGraphemes int, tail string) (offset int, truncated bool) {
+	iter := NewCharacterRBBI()
+	iter.SetCursor(NewStringCursor(text))
+
+	if maxGraphemes < 0 {
+		maxGraphemes = 0
+	}
+
+	keep := maxGraphemes - GraphemeCount(tail)
+
+	// Scan one grapheme cluster beyond the limit to find out whether the text
+	// needs to be truncated at all, while remembering the cut position.
+	offset = -1
+	if keep >= 0 {
+		offset = 0
+	}
+
+	for count := 0; count <= maxGraphemes; count++ {
+		position, ok := iter.Next()
+		if !ok {
+			return len(text), false
+		}
+
+		if count < keep {
+			offset = position
+		}
+	}
+
+	return offset, true
+}
@@ -0,0 +1,72 @@
+package rbbi
+
+import (
+	"testing"
+)
+
+func testTruncate(t *testing.T, str string, maxGraphemes int, tail string, expected string) {
+	if result := Truncate(str, maxGraphemes, tail); result != expected {
+		t.Errorf("Invalid truncation %q of %q, expected %q", result, str, expected)
+	}
+}
+
+func TestTruncateFits(t *testing.T) {
+	testTruncate(t, "", 3, "…", "")
+	testTruncate(t, "hello", 5, "…", "hello")
+	testTruncate(t, "🐨🏴‍☠️❤️‍🔥🥕", 4, "…", "🐨🏴‍☠️❤️‍🔥🥕")
+}
+
+func TestTruncateAscii(t *testing.T) {
+	testTruncate(t, "hello world", 5, "…", "hell…")
+	testTruncate(t, "hello world", 5, "...", "he...")
+	testTruncate(t, "hello world", 5, "", "hello")
+}
+
+func TestTruncateEmoji(t *testing.T) {
+	testTruncate(t, "🐨🏴‍☠️❤️‍🔥🥕", 3, "…", "🐨🏴‍☠️…")
+	testTruncate(t, "👩‍👩‍👧‍👦👩‍👩‍👧‍👦", 1, "", "👩‍👩‍👧‍👦")
+}
+
+func TestTruncateZalgo(t *testing.T) {
+	testTruncate(t, "h̷̝͈͉̎̇̋̓̄e̴̻̊̂̏̑̏l̸̢͚̬͇̗͂̿͠l̴̢̨̼͇̍̓͌͋o̷̫͋", 3, "…", "h̷̝͈͉̎̇̋̓̄e̴̻̊̂̏̑̏…")
+}
+
+func TestTruncateTailTooLong(t *testing.T) {
+	testTruncate(t, "hello", 2, "...", "he")
+	testTruncate(t, "hello", 0, "…", "")
+	testTruncate(t, "hello", -1, "…", "")
+}
+
+func TestTruncateWords(t *testing.T) {
+	str := "the quick brown fox"
+
+	if result := TruncateWords(str, 14, "…", 5); result != "the quick…" {
+		t.Errorf("Invalid word truncation %q", result)
+	}
+
+	if result := TruncateWords(str, 14, "…", 2); result != "the quick bro…" {
+		t.Errorf("Invalid word truncation %q beyond threshold", result)
+	}
+
+	if result := TruncateWords("abcdefghij", 5, "…", 10); result != "abcd…" {
+		t.Errorf("Invalid word truncation %q of a single word", result)
+	}
+
+	if result := TruncateWords(str, 30, "…", 5); result != str {
+		t.Errorf("Invalid word truncation %q of fitting string", result)
+	}
+}
+
+func TestTruncateWidth(t *testing.T) {
+	if result := TruncateWidth("日本語のテキスト", 7, "…"); result != "日本語…" {
+		t.Errorf("Invalid width truncation %q", result)
+	}
+
+	if result := TruncateWidth("日本語", 6, "…"); result != "日本語" {
+		t.Errorf("Invalid width truncation %q of fitting string", result)
+	}
+
+	if result := WidthModeWide.TruncateWidth("αβγδ", 5, "…"); result != "α…" {
+		t.Errorf("Invalid wide width truncation %q", result)
+	}
+}
@@ -25,8 +25,11 @@ type ucpTrie struct {
 	//indexLength uint16
 	dataLength int32 // Note: was originally a uint32 but runes are int32
 
-	index3NullOffset uint16 // TODO: Not used yet
-	dataNullOffset   uint16 // TODO: Not used yet
+	// Offsets of the index-3 block and data block that contain only null
+	// values, or 0x7fff and 0xffff respectively if there are no such blocks.
+	// They are used to skip over unassigned ranges when enumerating ranges.
+	index3NullOffset uint16
+	dataNullOffset   uint16
 	//shiftedHighStart uint16 // TODO: Redundant?
 
 	highStart          int32  // Note: was originally a uint32 but runes are int32
@@ -49,6 +52,10 @@ const (
 	ucpTrieSmallLimit       int32 = 0x1000
 	ucpTrieSmallIndexLength int32 = ucpTrieSmallLimit >> ucpTrieFastShift
 
+	// The maximum code point that is looked up using the BMP index of a
+	// small trie.
+	ucpTrieSmallMax int32 = ucpTrieSmallLimit - 1
+
 	// Number of entries in a data block for code points below the fast limit.
 	// 64=0x40 @internal
 	ucpTrieFastDataBlockLength int32 = 1 << ucpTrieFastShift
@@ -105,57 +112,87 @@ const (
 
 	// Mask for getting the lower bits for the in-small-data-block offset.
 	ucpTrieSmallDataMask int32 = ucpTrieSmallDataBlockLength - 1
+
+	// Number of code points per index-2 table entry. 512=0x200
+	ucpTrieCpPerIndex2Entry int32 = 1 << ucpTrieShift2
+
+	// The largest Unicode code point.
+	ucpTrieMaxUnicode rune = 0x10ffff
 )
 
-// Undocumented internal function.
-func (t *ucpTrie) internalSmallIndex(codePoint rune) int32 {
+// Undocumented internal function. Returns an error wrapping ErrCorruptTable
+// when the index of the trie is inconsistent.
+func (t *ucpTrie) internalSmallIndex(codePoint rune) (int32, error) {
 	var i1 int32 = codePoint >> ucpTrieShift1
 
 	if t.trieType == ucpTrieTypeFast {
 		if 0xffff >= codePoint || codePoint >= t.highStart {
-			panic("Assertion error")
+			return -1, corruptTableError("code point %U is outside the small index of the trie", codePoint)
 		}
 
 		i1 += ucpTrieBmpIndexLength - ucpTrieOmittedBmpIndex1Length
 	} else {
 		if codePoint >= t.highStart || t.highStart <= ucpTrieSmallLimit {
-			panic("Assertion error")
+			return -1, corruptTableError("code point %U is outside the small index of the trie", codePoint)
 		}
 
 		i1 += ucpTrieSmallIndexLength
 	}
 
-	var i3Block int32 = int32(t.index[int32(t.index[i1])+((codePoint>>ucpTrieShift2)&ucpTrieIndex2Mask)])
+	indexLength := int32(len(t.index))
+	if i1 >= indexLength {
+		return -1, corruptTableError("index-1 offset %v of the trie is out of range", i1)
+	}
+
+	i2 := int32(t.index[i1]) + ((codePoint >> ucpTrieShift2) & ucpTrieIndex2Mask)
+	if i2 >= indexLength {
+		return -1, corruptTableError("index-2 offset %v of the trie is out of range", i2)
+	}
+
+	var i3Block int32 = int32(t.index[i2])
 	var i3 int32 = (codePoint >> ucpTrieShift3) & ucpTrieIndex3Mask
 	var dataBlock int32
 
 	if (i3Block & 0x8000) == 0 {
 		// 16-bit indexes
+		if i3Block+i3 >= indexLength {
+			return -1, corruptTableError("index-3 offset %v of the trie is out of range", i3Block+i3)
+		}
+
 		dataBlock = int32(t.index[i3Block+i3])
 	} else {
 		// 18-bit indexes stored in groups of 9 entries per 8 indexes.
 		i3Block = (i3Block & 0x7fff) + (i3 & ^7) + (i3 >> 3)
 		i3 &= 7
 
+		if i3Block+1+i3 >= indexLength {
+			return -1, corruptTableError("index-3 offset %v of the trie is out of range", i3Block+1+i3)
+		}
+
 		dataBlock = (int32(t.index[i3Block]) << (2 + (2 * i3))) & 0x30000
 		i3Block++
 		dataBlock |= int32(t.index[i3Block+i3])
 	}
 
-	return dataBlock + (codePoint & ucpTrieSmallDataMask)
+	return dataBlock + (codePoint & ucpTrieSmallDataMask), nil
 }
 
 // Internal trie getter for a code point below the fast limit. Returns the data
 // index.
-func (t *ucpTrie) fastIndex(codePoint rune) int32 {
-	return int32(t.index[codePoint>>ucpTrieFastShift]) + (codePoint & ucpTrieFastDataMask)
+func (t *ucpTrie) fastIndex(codePoint rune) (int32, error) {
+	i := codePoint >> ucpTrieFastShift
+	if int(i) >= len(t.index) {
+		return -1, corruptTableError("fast index offset %v of the trie is out of range", i)
+	}
+
+	return int32(t.index[i]) + (codePoint & ucpTrieFastDataMask), nil
 }
 
 // Internal trie getter for a code point at or above the fast limit. Returns
 // the data index.
-func (t *ucpTrie) smallIndex(codePoint rune) int32 {
+func (t *ucpTrie) smallIndex(codePoint rune) (int32, error) {
 	if int32(codePoint) >= t.highStart {
-		return t.dataLength - ucpTrieHighValueNegDataOffset
+		return t.dataLength - ucpTrieHighValueNegDataOffset, nil
 	} else {
 		return t.internalSmallIndex(codePoint)
 	}
@@ -163,31 +200,245 @@ func (t *ucpTrie) smallIndex(codePoint rune) int32 {
 
 // Internal trie getter for a code point, with checking that codePoint is in
 // U+0000..10FFFF.
-func (t *ucpTrie) codePointIndex(fastMax int32, codePoint rune) int32 {
+func (t *ucpTrie) codePointIndex(fastMax int32, codePoint rune) (int32, error) {
+	if codePoint < 0 {
+		return t.dataLength - ucpTrieErrorValueNegDataOffset, nil
+	}
+
 	if int32(codePoint) <= fastMax {
 		return t.fastIndex(codePoint)
 	} else {
 		if codePoint <= 0x10ffff {
 			return t.smallIndex(codePoint)
 		} else {
-			return t.dataLength - ucpTrieErrorValueNegDataOffset
+			return t.dataLength - ucpTrieErrorValueNegDataOffset, nil
 		}
 	}
 }
 
 // Returns a trie value for a code point, with range checking. Returns the trie
-// error value if c is not in the range 0..U+10FFFF.
-func (t *ucpTrie) fastGet(codePoint rune) uint32 {
-	index := t.codePointIndex(0xffff, codePoint)
+// error value if c is not in the range 0..U+10FFFF. An error wrapping
+// ErrCorruptTable is returned when the trie data is inconsistent.
+func (t *ucpTrie) get(codePoint rune) (uint32, error) {
+	fastMax := int32(0xffff)
+	if t.trieType != ucpTrieTypeFast {
+		fastMax = ucpTrieSmallMax
+	}
+
+	index, err := t.codePointIndex(fastMax, codePoint)
+	if err != nil {
+		return 0, err
+	}
 
+	return t.value(index)
+}
+
+// Return the value at a data index. An error wrapping ErrCorruptTable is
+// returned when the index is out of range.
+func (t *ucpTrie) value(index int32) (uint32, error) {
 	switch t.valueWidth {
 	case ucpTrieValueWidth8:
-		return uint32(t.data8[index])
+		if index >= 0 && int(index) < len(t.data8) {
+			return uint32(t.data8[index]), nil
+		}
 	case ucpTrieValueWidth16:
-		return uint32(t.data16[index])
+		if index >= 0 && int(index) < len(t.data16) {
+			return uint32(t.data16[index]), nil
+		}
 	case ucpTrieValueWidth32:
-		return t.data32[index]
+		if index >= 0 && int(index) < len(t.data32) {
+			return t.data32[index], nil
+		}
+	default:
+		return 0, corruptTableError("invalid trie value width %v", t.valueWidth)
+	}
+
+	return 0, corruptTableError("data offset %v of the trie is out of range", index)
+}
+
+// Return the entry at an index of the index table. An error wrapping
+// ErrCorruptTable is returned when the index is out of range.
+func (t *ucpTrie) indexEntry(index int32) (int32, error) {
+	if index < 0 || int(index) >= len(t.index) {
+		return -1, corruptTableError("index offset %v of the trie is out of range", index)
+	}
+
+	return int32(t.index[index]), nil
+}
+
+// Return the last code point of the range of code points starting at start
+// that all have the same value, along with that value. The ranges of a trie
+// can be enumerated by calling getRange repeatedly, starting at 0 and then at
+// the end of the previous range plus one, until U+10FFFF is returned. The
+// null blocks of the trie are used to skip over unassigned ranges quickly.
+//
+// This is a port of getRange() from ICU's ucptrie.cpp, without the value
+// filter.
+func (t *ucpTrie) getRange(start rune) (end rune, value uint32, err error) {
+	if start < 0 || start > ucpTrieMaxUnicode {
+		return -1, 0, nil
+	}
+
+	if start >= t.highStart {
+		value, err := t.value(t.dataLength - ucpTrieHighValueNegDataOffset)
+		if err != nil {
+			return -1, 0, err
+		}
+
+		return ucpTrieMaxUnicode, value, nil
+	}
+
+	prevI3Block := int32(-1)
+	prevBlock := int32(-1)
+	c := start
+	haveValue := false
+
+	for c < t.highStart {
+		var i3Block, i3, i3BlockLength, dataBlockLength int32
+
+		if c <= 0xffff && (t.trieType == ucpTrieTypeFast || c <= ucpTrieSmallMax) {
+			i3Block = 0
+			i3 = c >> ucpTrieFastShift
+			dataBlockLength = ucpTrieFastDataBlockLength
+
+			if t.trieType == ucpTrieTypeFast {
+				i3BlockLength = ucpTrieBmpIndexLength
+			} else {
+				i3BlockLength = ucpTrieSmallIndexLength
+			}
+		} else {
+			// Use the multi-stage index.
+			i1 := c >> ucpTrieShift1
+			if t.trieType == ucpTrieTypeFast {
+				i1 += ucpTrieBmpIndexLength - ucpTrieOmittedBmpIndex1Length
+			} else {
+				i1 += ucpTrieSmallIndexLength
+			}
+
+			i2Block, err := t.indexEntry(i1)
+			if err != nil {
+				return -1, 0, err
+			}
+
+			i3Block, err = t.indexEntry(i2Block + ((c >> ucpTrieShift2) & ucpTrieIndex2Mask))
+			if err != nil {
+				return -1, 0, err
+			}
+
+			if i3Block == prevI3Block && c-start >= ucpTrieCpPerIndex2Entry {
+				// The index-3 block is the same as the previous one, and
+				// filled with value.
+				c += ucpTrieCpPerIndex2Entry
+				continue
+			}
+
+			prevI3Block = i3Block
+
+			if i3Block == int32(t.index3NullOffset) {
+				// This is the index-3 null block.
+				if haveValue {
+					if t.nullValue != value {
+						return c - 1, value, nil
+					}
+				} else {
+					value = t.nullValue
+					haveValue = true
+				}
+
+				prevBlock = int32(t.dataNullOffset)
+				c = (c + ucpTrieCpPerIndex2Entry) &^ (ucpTrieCpPerIndex2Entry - 1)
+				continue
+			}
+
+			i3 = (c >> ucpTrieShift3) & ucpTrieIndex3Mask
+			i3BlockLength = ucpTrieIndex3BlockLength
+			dataBlockLength = ucpTrieSmallDataBlockLength
+		}
+
+		// Enumerate data blocks for one index-3 block.
+		for ; i3 < i3BlockLength; i3++ {
+			var block int32
+
+			if (i3Block & 0x8000) == 0 {
+				block, err = t.indexEntry(i3Block + i3)
+				if err != nil {
+					return -1, 0, err
+				}
+			} else {
+				// 18-bit indexes stored in groups of 9 entries per 8 indexes.
+				group := (i3Block & 0x7fff) + (i3 &^ 7) + (i3 >> 3)
+				gi := i3 & 7
+
+				upper, err := t.indexEntry(group)
+				if err != nil {
+					return -1, 0, err
+				}
+
+				lower, err := t.indexEntry(group + 1 + gi)
+				if err != nil {
+					return -1, 0, err
+				}
+
+				block = ((upper << (2 + (2 * gi))) & 0x30000) | lower
+			}
+
+			if block == prevBlock && c-start >= dataBlockLength {
+				// The block is the same as the previous one, and filled with
+				// value.
+				c += dataBlockLength
+				continue
+			}
+
+			dataMask := dataBlockLength - 1
+			prevBlock = block
+
+			if block == int32(t.dataNullOffset) {
+				// This is the data null block.
+				if haveValue {
+					if t.nullValue != value {
+						return c - 1, value, nil
+					}
+				} else {
+					value = t.nullValue
+					haveValue = true
+				}
+
+				c = (c + dataBlockLength) &^ dataMask
+				continue
+			}
+
+			di := block + (c & dataMask)
+			for {
+				blockValue, err := t.value(di)
+				if err != nil {
+					return -1, 0, err
+				}
+
+				if !haveValue {
+					value = blockValue
+					haveValue = true
+				} else if blockValue != value {
+					return c - 1, value, nil
+				}
+
+				di++
+				c++
+
+				if c&dataMask == 0 {
+					break
+				}
+			}
+		}
+	}
+
+	highValue, err := t.value(t.dataLength - ucpTrieHighValueNegDataOffset)
+	if err != nil {
+		return -1, 0, err
+	}
+
+	if highValue != value {
+		return c - 1, value, nil
 	}
 
-	return 0
+	return ucpTrieMaxUnicode, value, nil
 }
@@ -0,0 +1,239 @@
+package rbbi
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/thedjinn/rbbi-go/internal/ucptrie"
+)
+
+// Convert a trie produced by the builder to the runtime representation.
+func newTestTrie(trie *ucptrie.Trie) *ucpTrie {
+	return &ucpTrie{
+		trieType:           ucpTrieType(trie.Type),
+		valueWidth:         ucpTrieValueWidth(trie.ValueWidth),
+		dataLength:         trie.DataLength,
+		index3NullOffset:   trie.Index3NullOffset,
+		dataNullOffset:     trie.DataNullOffset,
+		highStart:          trie.HighStart,
+		shifted12HighStart: trie.Shifted12HighStart,
+		nullValueOffset:    trie.NullValueOffset,
+		index:              trie.Index,
+		data8:              trie.Data8,
+		data16:             trie.Data16,
+		data32:             trie.Data32,
+		nullValue:          trie.NullValue,
+	}
+}
+
+// Compare the values of a trie with the reference values of a builder for
+// every code point, and check that the enumerated ranges are correct and as
+// long as possible.
+func checkTrie(t *testing.T, name string, trie *ucpTrie, reference func(rune) uint32, errorValue uint32) {
+	t.Helper()
+
+	for c := rune(0); c <= ucpTrieMaxUnicode; c++ {
+		value, err := trie.get(c)
+		if err != nil || value != reference(c) {
+			t.Fatalf("%v: invalid value %#x for %U, expected %#x (%v)", name, value, c, reference(c), err)
+		}
+	}
+
+	for _, c := range []rune{-1, ucpTrieMaxUnicode + 1, 0x7fffffff} {
+		if value, err := trie.get(c); err != nil || value != errorValue {
+			t.Errorf("%v: invalid error value %#x for %#x", name, value, c)
+		}
+	}
+
+	start := rune(0)
+	for start <= ucpTrieMaxUnicode {
+		end, value, err := trie.getRange(start)
+		if err != nil || end < start {
+			t.Fatalf("%v: invalid range %U..%U (%v)", name, start, end, err)
+		}
+
+		// Checking the first and last code points is enough, because every
+		// code point was compared above
+		if reference(start) != value || reference(end) != value {
+			t.Fatalf("%v: invalid value %#x for range %U..%U", name, value, start, end)
+		}
+
+		if end < ucpTrieMaxUnicode && reference(end+1) == value {
+			t.Fatalf("%v: range %U..%U is not as long as possible", name, start, end)
+		}
+
+		start = end + 1
+	}
+
+	if end, _, _ := trie.getRange(ucpTrieMaxUnicode + 1); end != -1 {
+		t.Errorf("%v: invalid range for out of range code point", name)
+	}
+}
+
+// Build tries of all types and value widths from a builder and check them.
+func checkBuilder(t *testing.T, name string, builder *ucptrie.Builder, errorValue uint32, widths []ucptrie.ValueWidth) {
+	for _, trieType := range []ucptrie.Type{ucptrie.TypeFast, ucptrie.TypeSmall} {
+		for _, valueWidth := range widths {
+			built, err := builder.Build(trieType, valueWidth)
+			if err != nil {
+				t.Fatalf("%v: %v", name, err)
+			}
+
+			checkTrie(t, fmt.Sprintf("%v (type %v, width %v)", name, trieType, valueWidth), newTestTrie(built), builder.Get, errorValue)
+		}
+	}
+}
+
+var allValueWidths = []ucptrie.ValueWidth{ucptrie.ValueWidth8, ucptrie.ValueWidth16, ucptrie.ValueWidth32}
+
+func TestTrieRanges(t *testing.T) {
+	builder := ucptrie.NewBuilder(0, 0xee)
+	builder.SetRange(0x41, 0x5a, 1)
+	builder.SetRange(0x61, 0x7a, 2)
+	builder.Set(0xe9, 3)
+	builder.SetRange(0x3040, 0x309f, 4)
+	builder.SetRange(0x4e00, 0x9fff, 5)
+	builder.SetRange(0xfff0, 0x1000f, 6)
+	builder.SetRange(0x1f300, 0x1faff, 7)
+	builder.SetRange(0x20000, 0x2fffd, 5)
+	builder.SetRange(0xe0100, 0xe01ef, 8)
+
+	checkBuilder(t, "ranges", builder, 0xee, allValueWidths)
+}
+
+func TestTrieHighValue(t *testing.T) {
+	// A non-null value for the code points at and above highStart
+	builder := ucptrie.NewBuilder(1, 2)
+	builder.SetRange(0x80, 0x7ff, 0)
+	builder.SetRange(0x50000, 0x10ffff, 3)
+
+	checkBuilder(t, "high value", builder, 2, allValueWidths)
+}
+
+func TestTrieWideValues(t *testing.T) {
+	builder := ucptrie.NewBuilder(0x1234, 0xffff)
+	builder.SetRange(0x100, 0x1ff, 0xfffe)
+	builder.SetRange(0x10000, 0x1ffff, 0x8000)
+
+	checkBuilder(t, "16-bit values", builder, 0xffff, []ucptrie.ValueWidth{ucptrie.ValueWidth16, ucptrie.ValueWidth32})
+
+	builder = ucptrie.NewBuilder(0x12345678, 0xffffffff)
+	builder.SetRange(0x100, 0x1ff, 0xdeadbeef)
+	builder.SetRange(0x10000, 0x1ffff, 0x80000000)
+
+	checkBuilder(t, "32-bit values", builder, 0xffffffff, []ucptrie.ValueWidth{ucptrie.ValueWidth32})
+}
+
+func TestTrie18BitIndex(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping large trie in short mode")
+	}
+
+	// Pseudo-random values make every data block unique, so that the data
+	// grows beyond the range of 16-bit offsets.
+	builder := ucptrie.NewBuilder(0, 0)
+
+	state := uint32(1)
+	for c := rune(0x10000); c < 0x40000; c++ {
+		state ^= state << 13
+		state ^= state >> 17
+		state ^= state << 5
+		builder.Set(c, state&0xff)
+	}
+
+	for _, trieType := range []ucptrie.Type{ucptrie.TypeFast, ucptrie.TypeSmall} {
+		built, err := builder.Build(trieType, ucptrie.ValueWidth8)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wide := false
+		for _, entry := range built.Index {
+			if entry&0x8000 != 0 {
+				wide = true
+			}
+		}
+
+		if !wide || built.DataLength <= 0xffff {
+			t.Fatal("The trie does not use 18-bit indexes")
+		}
+
+		checkTrie(t, fmt.Sprintf("18-bit index (type %v)", trieType), newTestTrie(built), builder.Get, 0)
+	}
+}
+
+func TestEmbeddedTries(t *testing.T) {
+	tries := map[string]*ucpTrie{
+		"character": &decodedData(&characterRules).trie,
+		"line":      &decodedData(&lineRules).trie,
+		"sentence":  &decodedData(&sentenceRules).trie,
+		"word":      &decodedData(&wordRules).trie,
+		"width":     &widthTrie,
+	}
+
+	for name, trie := range tries {
+		errorValue, _ := trie.get(-1)
+
+		// Use the values of the embedded trie as the reference for
+		// rebuilding it using all types and value widths
+		builder := ucptrie.NewBuilder(trie.nullValue, errorValue)
+
+		for start := rune(0); start <= ucpTrieMaxUnicode; {
+			end, value, err := trie.getRange(start)
+			if err != nil {
+				t.Fatalf("%v: %v", name, err)
+			}
+
+			builder.SetRange(start, end, value)
+			start = end + 1
+		}
+
+		checkTrie(t, name, trie, builder.Get, errorValue)
+
+		if !testing.Short() {
+			checkBuilder(t, name, builder, errorValue, allValueWidths)
+		}
+	}
+}
+
+func TestEmbeddedTrieCategories(t *testing.T) {
+	// Known categories of the ICU rules, to catch tries that are consistent
+	// but map code points to the wrong categories
+	cases := []struct {
+		rules    *Rules
+		c        rune
+		category uint32
+		name     string
+	}{
+		{&characterRules, '\r', 5, "CR"},
+		{&characterRules, '\n', 4, "LF"},
+		{&characterRules, 0x01, 3, "Control"},
+		{&characterRules, 0x200C, 9, "Extend"},
+		{&characterRules, 0x0300, 8, "InCB_Extend"},
+		{&characterRules, 0x200D, 17, "ZWJ"},
+		{&characterRules, 0x1F1E6, 20, "Regional_Indicator"},
+		{&characterRules, 0x1F600, 7, "Extended_Pictographic"},
+		{&characterRules, 0xAC00, 18, "LV"},
+		{&lineRules, ' ', 8, "SP"},
+		{&lineRules, '.', 16, "IS"},
+		{&lineRules, 0x0E31, 44, "SA_Mark"},
+		{&sentenceRules, 'a', 13, "Lower"},
+		{&sentenceRules, '.', 10, "ATerm"},
+		{&wordRules, 'a', 14, "ALetter"},
+		{&wordRules, 0x0300, 17, "Extend_Format"},
+		{&wordRules, 0x1F1E6, 23, "Regional_Indicator"},
+	}
+
+	for _, test := range cases {
+		data := decodedData(test.rules)
+
+		category, err := data.trie.get(test.c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if category != test.category || data.categoryNames[category] != test.name {
+			t.Errorf("Invalid category %v %v of %U, expected %v %v", category, data.categoryNames[category], test.c, test.category, test.name)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package rbbi
+
+// Display width properties of a code point, as stored in widthTrie. The lower
+// two bits hold the width class, the other bits are flags. These values must
+// be kept in sync with gen_width.go.
+const (
+	widthZero      uint32 = 0
+	widthNarrow    uint32 = 1
+	widthWide      uint32 = 2
+	widthAmbiguous uint32 = 3
+
+	widthClassMask uint32 = 0x3
+
+	// The code point has the Emoji_Presentation property
+	widthEmojiPresentation uint32 = 0x4
+
+	// The code point can be followed by a VS15/VS16 variation selector
+	widthVariationBase uint32 = 0x8
+)
+
+const (
+	// VARIATION SELECTOR-15, requests text presentation
+	variationSelector15 rune = 0xfe0e
+
+	// VARIATION SELECTOR-16, requests emoji presentation
+	variationSelector16 rune = 0xfe0f
+)
+
+// The WidthMode determines how characters with an East Asian Width of
+// Ambiguous are measured. Most terminals render these characters using a
+// single cell, but terminals configured for CJK locales use two cells.
+type WidthMode int
+
+const (
+	// Measure ambiguous characters as narrow (one cell).
+	WidthModeNarrow WidthMode = iota
+
+	// Measure ambiguous characters as wide (two cells).
+	WidthModeWide
+)
+
+// Return the number of terminal cells that a string occupies, measuring
+// ambiguous characters as narrow. See WidthMode.StringWidth() for details.
+func StringWidth(text string) int {
+	return WidthModeNarrow.StringWidth(text)
+}
+
+// Return the number of terminal cells that a single grapheme cluster occupies,
+// measuring ambiguous characters as narrow. See WidthMode.GraphemeWidth() for
+// details.
+func GraphemeWidth(grapheme string) int {
+	return WidthModeNarrow.GraphemeWidth(grapheme)
+}
+
+// Return the number of terminal cells that a string occupies. The string is
+// split into grapheme clusters using a character break iterator, after which
+// the widths of the individual grapheme clusters are summed.
+func (m WidthMode) StringWidth(text string) int {
+	iter := NewCharacterRBBI()
+	iter.SetCursor(NewStringCursor(text))
+
+	width := 0
+	start := 0
+	for {
+		end, ok := iter.Next()
+		if !ok {
+			break
+		}
+
+		width += m.GraphemeWidth(text[start:end])
+		start = end
+	}
+
+	return width
+}
+
+// Return the number of terminal cells that a single grapheme cluster occupies.
+// The width is determined by the first code point that is not zero width:
+// East Asian Wide and Fullwidth characters and emoji presentation characters
+// occupy two cells, combining marks and control characters occupy none, and
+// ambiguous characters are measured according to the WidthMode. A trailing
+// VS16 selector turns a text presentation emoji into a wide emoji, whereas a
+// VS15 selector turns an emoji presentation character into a narrow one.
+//
+// The grapheme argument is expected to contain a single grapheme cluster, as
+// returned by a character break iterator.
+func (m WidthMode) GraphemeWidth(grapheme string) int {
+	var base uint32
+	width := 0
+
+	for _, r := range grapheme {
+		// The width trie is generated along with the package, so it can't be
+		// corrupt.
+		properties, _ := widthTrie.get(r)
+
+		if width == 0 {
+			width = m.classWidth(properties & widthClassMask)
+			base = properties
+
+			continue
+		}
+
+		if base&widthVariationBase == 0 {
+			continue
+		}
+
+		switch r {
+		case variationSelector15:
+			if base&widthEmojiPresentation != 0 {
+				width = 1
+			}
+		case variationSelector16:
+			width = 2
+		}
+	}
+
+	return width
+}
+
+// Return the number of cells for a width class.
+func (m WidthMode) classWidth(class uint32) int {
+	switch class {
+	case widthZero:
+		return 0
+	case widthWide:
+		return 2
+	case widthAmbiguous:
+		if m == WidthModeWide {
+			return 2
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,70 @@
+package rbbi
+
+import (
+	"testing"
+)
+
+func testStringWidth(t *testing.T, mode WidthMode, str string, width int) {
+	if w := mode.StringWidth(str); w != width {
+		t.Errorf("Invalid width %v for %q, expected %v", w, str, width)
+	}
+}
+
+func TestStringWidthEmpty(t *testing.T) {
+	testStringWidth(t, WidthModeNarrow, "", 0)
+}
+
+func TestStringWidthAscii(t *testing.T) {
+	testStringWidth(t, WidthModeNarrow, "hello", 5)
+}
+
+func TestStringWidthControl(t *testing.T) {
+	testStringWidth(t, WidthModeNarrow, "a\tb\r\n", 2)
+}
+
+func TestStringWidthWide(t *testing.T) {
+	testStringWidth(t, WidthModeNarrow, "日本語", 6)
+	testStringWidth(t, WidthModeNarrow, "ＡＢ", 4)
+	testStringWidth(t, WidthModeNarrow, "ｱｲ", 2)
+}
+
+func TestStringWidthHangul(t *testing.T) {
+	// Precomposed and conjoining jamo forms of the same syllables
+	testStringWidth(t, WidthModeNarrow, "한국", 4)
+	testStringWidth(t, WidthModeNarrow, "한국", 4)
+}
+
+func TestStringWidthCombining(t *testing.T) {
+	testStringWidth(t, WidthModeNarrow, "é", 1)
+	testStringWidth(t, WidthModeNarrow, "h̷̝͈͉̎̇̋̓̄e̴̻̊̂̏̑̏l̸̢͚̬͇̗͂̿͠l̴̢̨̼͇̍̓͌͋o̷̫͋", 5)
+}
+
+func TestStringWidthEmoji(t *testing.T) {
+	testStringWidth(t, WidthModeNarrow, "🐨🏴‍☠️❤️‍🔥🥕", 8)
+	testStringWidth(t, WidthModeNarrow, "👍🏽", 2)
+	testStringWidth(t, WidthModeNarrow, "🇳🇱", 2)
+}
+
+func TestStringWidthVariationSelectors(t *testing.T) {
+	testStringWidth(t, WidthModeNarrow, "❤", 1)
+	testStringWidth(t, WidthModeNarrow, "❤️", 2)
+	testStringWidth(t, WidthModeNarrow, "⌚", 2)
+	testStringWidth(t, WidthModeNarrow, "⌚︎", 1)
+	testStringWidth(t, WidthModeNarrow, "1️⃣", 2)
+}
+
+func TestStringWidthAmbiguous(t *testing.T) {
+	testStringWidth(t, WidthModeNarrow, "αβ→", 3)
+	testStringWidth(t, WidthModeWide, "αβ→", 6)
+	testStringWidth(t, WidthModeWide, "hello", 5)
+}
+
+func TestGraphemeWidth(t *testing.T) {
+	if w := GraphemeWidth("👩‍👩‍👧‍👦"); w != 2 {
+		t.Errorf("Invalid width %v for family emoji", w)
+	}
+
+	if w := GraphemeWidth("́"); w != 0 {
+		t.Errorf("Invalid width %v for lone combining mark", w)
+	}
+}